@@ -25,34 +25,128 @@ namespace TienLen.Gen {
       byte[] descriptorData = global::System.Convert.FromBase64String(
           string.Concat(
             "CgpnYW1lLnByb3RvEgNhcGkiIgoEQ2FyZBIMCgRzdWl0GAEgASgFEgwKBHJh",
-            "bmsYAiABKAUiKwoQSGFuZFVwZGF0ZVBhY2tldBIXCgRoYW5kGAEgAygLMgku",
-            "YXBpLkNhcmQiUQoQTWF0Y2hTdGFydFBhY2tldBIXCgRoYW5kGAEgAygLMgku",
-            "YXBpLkNhcmQSEgoKcGxheWVyX2lkcxgCIAMoCRIQCghvd25lcl9pZBgDIAEo",
-            "CSIjCg5HYW1lT3ZlclBhY2tldBIRCgl3aW5uZXJfaWQYASABKAkiIwoOUm91",
-            "bmRFbmRQYWNrZXQSEQoJd2lubmVyX2lkGAEgASgJIoABChBNYXRjaFN0YXRl",
-            "UGFja2V0EhIKCmlzX3BsYXlpbmcYASABKAgSEAoIb3duZXJfaWQYAiABKAkS",
-            "GAoFYm9hcmQYAyADKAsyCS5hcGkuQ2FyZBIYChBhY3RpdmVfcGxheWVyX2lk",
-            "GAQgASgJEhIKCnBsYXllcl9pZHMYBSADKAkiJwoPUGxheUNhcmRSZXF1ZXN0",
-            "EhQKDGNhcmRfaW5kaWNlcxgBIAMoBSJtChBUdXJuVXBkYXRlUGFja2V0EhgK",
-            "EGFjdGl2ZV9wbGF5ZXJfaWQYASABKAkSJAoRbGFzdF9wbGF5ZWRfY2FyZHMY",
-            "AiADKAsyCS5hcGkuQ2FyZBIZChFzZWNvbmRzX3JlbWFpbmluZxgDIAEoBSro",
-            "AQoGT3BDb2RlEg4KCk9QX1VOS05PV04QABIRCg1PUF9HQU1FX1NUQVJUEAES",
-            "EAoMT1BfUExBWV9DQVJEEAISEgoOT1BfVFVSTl9VUERBVEUQAxIMCghPUF9F",
-            "UlJPUhAEEhkKFU9QX0dBTUVfU1RBUlRfUkVRVUVTVBAFEhMKD09QX09XTkVS",
-            "X1VQREFURRAGEhAKDE9QX0dBTUVfT1ZFUhAHEhIKDk9QX01BVENIX1NUQVRF",
-            "EAgSEgoOT1BfSEFORF9VUERBVEUQCRILCgdPUF9QQVNTEAoSEAoMT1BfUk9V",
-            "TkRfRU5EEAtCFFoELi9wYqoCC1RpZW5MZW4uR2VuYgZwcm90bzM="));
+            "bmsYAiABKAUiPAoQSGFuZFVwZGF0ZVBhY2tldBIXCgRoYW5kGAEgAygLMgku",
+            "YXBpLkNhcmQSDwoHdmVyc2lvbhgCIAEoBSLgAQoQTWF0Y2hTdGFydFBhY2tl",
+            "dBIXCgRoYW5kGAEgAygLMgkuYXBpLkNhcmQSEgoKcGxheWVyX2lkcxgCIAMo",
+            "CRIQCghvd25lcl9pZBgDIAEoCRIXCg9kZWFsX2NvbW1pdG1lbnQYBCABKAkS",
+            "FgoOY2xpZW50X2VudHJvcHkYBSABKAkSFAoMaGFuZF92ZXJzaW9uGAYgASgF",
+            "EhEKCWhhbmRfc2l6ZRgHIAEoBRIfCgxvcGVuaW5nX2NhcmQYCCABKAsyCS5h",
+            "cGkuQ2FyZBISCgp0dXJuX29yZGVyGAkgAygJInkKDkdhbWVPdmVyUGFja2V0",
+            "EhEKCXdpbm5lcl9pZBgBIAEoCRIRCglzdGFuZGluZ3MYAiADKAkSIgoHcmVz",
+            "dWx0cxgDIAMoCzIRLmFwaS5QbGF5ZXJSZXN1bHQSHQoEZGVhbBgEIAEoCzIP",
+            "LmFwaS5EZWFsUmV2ZWFsIrMBCgpEZWFsUmV2ZWFsEhMKC3NlcnZlcl9zZWVk",
+            "GAEgASgJEhYKDmNsaWVudF9lbnRyb3B5GAIgASgJEhIKCmNvbW1pdG1lbnQY",
+            "AyABKAkSEgoKcGxheWVyX2lkcxgEIAMoCRIXCgRkZWNrGAUgAygLMgkuYXBp",
+            "LkNhcmQSEQoJc2VlZF9oYXNoGAYgASgJEhEKCWhhbmRfc2l6ZRgHIAEoBRIR",
+            "CglkaXJlY3Rpb24YCCABKAki4AEKDFBsYXllclJlc3VsdBIRCglwbGF5ZXJf",
+            "aWQYASABKAkSDQoFcGxhY2UYAiABKAUSDAoEY29uZxgDIAEoCBIhCg5yZW1h",
+            "aW5pbmdfaGFuZBgEIAMoCzIJLmFwaS5DYXJkEhgKEHBsYWNlbWVudF9wb2lu",
+            "dHMYBSABKAUSEwoLY29uZ19wb2ludHMYBiABKAUSFwoPbGVmdG92ZXJfcG9p",
+            "bnRzGAcgASgFEhMKC2Nob3BfcG9pbnRzGAggASgFEg0KBXRvdGFsGAkgASgF",
+            "EhEKCWFiYW5kb25lZBgKIAEoCCJPChBJbnN0YW50V2luUGFja2V0EhEKCXBs",
+            "YXllcl9pZBgBIAEoCRIPCgdwYXR0ZXJuGAIgASgJEhcKBGhhbmQYAyADKAsy",
+            "CS5hcGkuQ2FyZCKUAQoKQ2hvcFBhY2tldBISCgpjaG9wcGVyX2lkGAEgASgJ",
+            "EhEKCXZpY3RpbV9pZBgCIAEoCRIgCg1jaG9wcGVkX2NhcmRzGAMgAygLMgku",
+            "YXBpLkNhcmQSHQoKYm9tYl9jYXJkcxgEIAMoCzIJLmFwaS5DYXJkEg8KB3Bl",
+            "bmFsdHkYBSABKAUSDQoFY2hhaW4YBiABKAUiIwoOUm91bmRFbmRQYWNrZXQS",
+            "EQoJd2lubmVyX2lkGAEgASgJIrcEChBNYXRjaFN0YXRlUGFja2V0EhIKCmlz",
+            "X3BsYXlpbmcYASABKAgSEAoIb3duZXJfaWQYAiABKAkSGAoFYm9hcmQYAyAD",
+            "KAsyCS5hcGkuQ2FyZBIYChBhY3RpdmVfcGxheWVyX2lkGAQgASgJEhIKCnBs",
+            "YXllcl9pZHMYBSADKAkSDwoHdmFyaWFudBgGIAEoCRIPCgdib3RfaWRzGAcg",
+            "AygJEhYKDm5leHRfc2VlZF9oYXNoGAggASgJEhQKDHBsYXllcl9jb3VudBgJ",
+            "IAEoBRIRCgloYW5kX3NpemUYCiABKAUSHwoMb3BlbmluZ19jYXJkGAsgASgL",
+            "MgkuYXBpLkNhcmQSEgoKc2VhdF9jb3VudBgMIAEoBRIdChV0dXJuX2RlYWRs",
+            "aW5lX3VuaXhfbXMYDSABKAMSOwoMdGltZV9iYW5rX21zGA4gAygLMiUuYXBp",
+            "Lk1hdGNoU3RhdGVQYWNrZXQuVGltZUJhbmtNc0VudHJ5EhgKEGRpc2Nvbm5l",
+            "Y3RlZF9pZHMYDyADKAkSDQoFcGhhc2UYECABKAkSHgoWcGhhc2VfZGVhZGxp",
+            "bmVfdW5peF9tcxgRIAEoAxIRCglyZWFkeV9pZHMYEiADKAkSEQoJZGlyZWN0",
+            "aW9uGBMgASgJEh8KBmNvbmZpZxgUIAEoCzIPLmFwaS5Sb29tQ29uZmlnGjEK",
+            "D1RpbWVCYW5rTXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAM6",
+            "AjgBIuACCgpSb29tQ29uZmlnEg8KB3ZhcmlhbnQYASABKAkSDQoFc2VhdHMY",
+            "AiABKAUSEQoJaGFuZF9zaXplGAMgASgFEhAKCGRlYWxfYWxsGAQgASgIEhEK",
+            "CWRpcmVjdGlvbhgFIAEoCRIUCgx0dXJuX3NlY29uZHMYBiABKAUSGQoRdGlt",
+            "ZV9iYW5rX3NlY29uZHMYByABKAUSGQoRaW5jcmVtZW50X3NlY29uZHMYCCAB",
+            "KAUSIAoYZGlzY29ubmVjdF9ncmFjZV9zZWNvbmRzGAkgASgFEg0KBXN0YWtl",
+            "GAogASgDEg8KB3ByaXZhdGUYCyABKAgSFQoNaGludHNfYWxsb3dlZBgMIAEo",
+            "CBIWCg5tYXhfc3BlY3RhdG9ycxgNIAEoBRIUCgxhdXRvX3Jlc3RhcnQYDiAB",
+            "KAgSFAoMaGFzX3Bhc3N3b3JkGA8gASgIEhEKCWpvaW5fY29kZRgQIAEoCSIf",
+            "CgxSZWFkeVJlcXVlc3QSDwoHZW50cm9weRgBIAEoCSIhCg1BZGRCb3RSZXF1",
+            "ZXN0EhAKCHN0cmF0ZWd5GAEgASgJIiIKEFJlbW92ZUJvdFJlcXVlc3QSDgoG",
+            "Ym90X2lkGAEgASgJIlcKD1BsYXlDYXJkUmVxdWVzdBIUCgxjYXJkX2luZGlj",
+            "ZXMYASADKAUSGAoFY2FyZHMYAiADKAsyCS5hcGkuQ2FyZBIUCgxoYW5kX3Zl",
+            "cnNpb24YAyABKAUiOgoISGludE1vdmUSFAoMY2FyZF9pbmRpY2VzGAEgAygF",
+            "EhgKBWNhcmRzGAIgAygLMgkuYXBpLkNhcmQiPAoKSGludFBhY2tldBIcCgVt",
+            "b3ZlcxgBIAMoCzINLmFwaS5IaW50TW92ZRIQCghjYW5fcGFzcxgCIAEoCCL3",
+            "AQoQVHVyblVwZGF0ZVBhY2tldBIYChBhY3RpdmVfcGxheWVyX2lkGAEgASgJ",
+            "EiQKEWxhc3RfcGxheWVkX2NhcmRzGAIgAygLMgkuYXBpLkNhcmQSGQoRc2Vj",
+            "b25kc19yZW1haW5pbmcYAyABKAUSGAoQZGVhZGxpbmVfdW5peF9tcxgEIAEo",
+            "AxI7Cgx0aW1lX2JhbmtfbXMYBSADKAsyJS5hcGkuVHVyblVwZGF0ZVBhY2tl",
+            "dC5UaW1lQmFua01zRW50cnkaMQoPVGltZUJhbmtNc0VudHJ5EgsKA2tleRgB",
+            "IAEoCRINCgV2YWx1ZRgCIAEoAzoCOAEiQQoSVHVyblRpbWVkT3V0UGFja2V0",
+            "EhEKCXBsYXllcl9pZBgBIAEoCRIYCgVjYXJkcxgCIAMoCzIJLmFwaS5DYXJk",
+            "IuMGCglHYW1lU3RhdGUSDwoHdmVyc2lvbhgBIAEoBRIPCgd2YXJpYW50GAIg",
+            "ASgJEgwKBHNlZWQYAyABKAMSEgoKaXNfcGxheWluZxgEIAEoCBIQCghvd25l",
+            "cl9pZBgFIAEoCRIPCgdwbGF5ZXJzGAYgAygJEhIKCnR1cm5fb3JkZXIYByAD",
+            "KAkSEwoLY3VycmVudF9pZHgYCCABKAUSKAoFaGFuZHMYCSADKAsyGS5hcGku",
+            "R2FtZVN0YXRlLkhhbmRzRW50cnkSNwoNaGFuZF92ZXJzaW9ucxgKIAMoCzIg",
+            "LmFwaS5HYW1lU3RhdGUuSGFuZFZlcnNpb25zRW50cnkSFwoEZGVjaxgLIAMo",
+            "CzIJLmFwaS5DYXJkEiMKCmNvbW1pdG1lbnQYDCABKAsyDy5hcGkuRGVhbFJl",
+            "dmVhbBIYCgVib2FyZBgNIAMoCzIJLmFwaS5DYXJkEhIKCmxhc3RfYWN0b3IY",
+            "DiABKAkSFgoOcm91bmRfc2tpcHBlcnMYDyADKAkSFwoPY2hvcF9jaGFpbl9v",
+            "cGVuGBAgASgIEg8KB3dpbm5lcnMYESADKAkSGAoQZmluaXNoZWRfcGxheWVy",
+            "cxgSIAMoCRIeCgVjaG9wcxgTIAMoCzIPLmFwaS5DaG9wUGFja2V0EjUKDGNh",
+            "cmRzX3BsYXllZBgUIAMoCzIfLmFwaS5HYW1lU3RhdGUuQ2FyZHNQbGF5ZWRF",
+            "bnRyeRIcChRlbmRlZF9ieV9pbnN0YW50X3dpbhgVIAEoCBIaCgNsb2cYFiAD",
+            "KAsyDS5hcGkuTG9nRW50cnkSHQoEZGVhbBgXIAEoCzIPLmFwaS5EZWFsQ29u",
+            "ZmlnEhEKCWhhbmRfc2l6ZRgYIAEoBRIfCgxvcGVuaW5nX2NhcmQYGSABKAsy",
+            "CS5hcGkuQ2FyZBIRCglhYmFuZG9uZWQYGiADKAkaOwoKSGFuZHNFbnRyeRIL",
+            "CgNrZXkYASABKAkSHAoFdmFsdWUYAiABKAsyDS5hcGkuQ2FyZExpc3Q6AjgB",
+            "GjMKEUhhbmRWZXJzaW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgC",
+            "IAEoBToCOAEaMgoQQ2FyZHNQbGF5ZWRFbnRyeRILCgNrZXkYASABKAkSDQoF",
+            "dmFsdWUYAiABKAU6AjgBIkQKCkRlYWxDb25maWcSEQoJaGFuZF9zaXplGAEg",
+            "ASgFEhAKCGRlYWxfYWxsGAIgASgIEhEKCWRpcmVjdGlvbhgDIAEoCSLzAQoI",
+            "TG9nRW50cnkSCwoDc2VxGAEgASgFEgwKBGtpbmQYAiABKAkSEQoJcGxheWVy",
+            "X2lkGAMgASgJEhgKBWNhcmRzGAQgAygLMgkuYXBpLkNhcmQSDwoHdmFyaWFu",
+            "dBgFIAEoCRIMCgRzZWVkGAYgASgDEhIKCnBsYXllcl9pZHMYByADKAkSEAoI",
+            "b3duZXJfaWQYCCABKAkSFgoObGFzdF93aW5uZXJfaWQYCSABKAkSIwoKY29t",
+            "bWl0bWVudBgKIAEoCzIPLmFwaS5EZWFsUmV2ZWFsEh0KBGRlYWwYCyABKAsy",
+            "Dy5hcGkuRGVhbENvbmZpZyIkCghDYXJkTGlzdBIYCgVjYXJkcxgBIAMoCzIJ",
+            "LmFwaS5DYXJkKvMCCgZPcENvZGUSDgoKT1BfVU5LTk9XThAAEhEKDU9QX0dB",
+            "TUVfU1RBUlQQARIQCgxPUF9QTEFZX0NBUkQQAhISCg5PUF9UVVJOX1VQREFU",
+            "RRADEgwKCE9QX0VSUk9SEAQSGQoVT1BfR0FNRV9TVEFSVF9SRVFVRVNUEAUS",
+            "EwoPT1BfT1dORVJfVVBEQVRFEAYSEAoMT1BfR0FNRV9PVkVSEAcSEgoOT1Bf",
+            "TUFUQ0hfU1RBVEUQCBISCg5PUF9IQU5EX1VQREFURRAJEgsKB09QX1BBU1MQ",
+            "ChIQCgxPUF9ST1VORF9FTkQQCxISCg5PUF9JTlNUQU5UX1dJThAMEgsKB09Q",
+            "X0NIT1AQDRITCg9PUF9ISU5UX1JFUVVFU1QQDhILCgdPUF9ISU5UEA8SDgoK",
+            "T1BfQUREX0JPVBAQEhEKDU9QX1JFTU9WRV9CT1QQERIVChFPUF9UVVJOX1RJ",
+            "TUVEX09VVBASEgwKCE9QX1JFQURZEBNCFFoELi9wYqoCC1RpZW5MZW4uR2Vu",
+            "YgZwcm90bzM="));
       descriptor = pbr::FileDescriptor.FromGeneratedCode(descriptorData,
           new pbr::FileDescriptor[] { },
           new pbr::GeneratedClrTypeInfo(new[] {typeof(global::TienLen.Gen.OpCode), }, null, new pbr::GeneratedClrTypeInfo[] {
             new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.Card), global::TienLen.Gen.Card.Parser, new[]{ "Suit", "Rank" }, null, null, null, null),
-            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.HandUpdatePacket), global::TienLen.Gen.HandUpdatePacket.Parser, new[]{ "Hand" }, null, null, null, null),
-            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.MatchStartPacket), global::TienLen.Gen.MatchStartPacket.Parser, new[]{ "Hand", "PlayerIds", "OwnerId" }, null, null, null, null),
-            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.GameOverPacket), global::TienLen.Gen.GameOverPacket.Parser, new[]{ "WinnerId" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.HandUpdatePacket), global::TienLen.Gen.HandUpdatePacket.Parser, new[]{ "Hand", "Version" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.MatchStartPacket), global::TienLen.Gen.MatchStartPacket.Parser, new[]{ "Hand", "PlayerIds", "OwnerId", "DealCommitment", "ClientEntropy", "HandVersion", "HandSize", "OpeningCard", "TurnOrder" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.GameOverPacket), global::TienLen.Gen.GameOverPacket.Parser, new[]{ "WinnerId", "Standings", "Results", "Deal" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.DealReveal), global::TienLen.Gen.DealReveal.Parser, new[]{ "ServerSeed", "ClientEntropy", "Commitment", "PlayerIds", "Deck", "SeedHash", "HandSize", "Direction" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.PlayerResult), global::TienLen.Gen.PlayerResult.Parser, new[]{ "PlayerId", "Place", "Cong", "RemainingHand", "PlacementPoints", "CongPoints", "LeftoverPoints", "ChopPoints", "Total", "Abandoned" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.InstantWinPacket), global::TienLen.Gen.InstantWinPacket.Parser, new[]{ "PlayerId", "Pattern", "Hand" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.ChopPacket), global::TienLen.Gen.ChopPacket.Parser, new[]{ "ChopperId", "VictimId", "ChoppedCards", "BombCards", "Penalty", "Chain" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.RoundEndPacket), global::TienLen.Gen.RoundEndPacket.Parser, new[]{ "WinnerId" }, null, null, null, null),
-            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.MatchStatePacket), global::TienLen.Gen.MatchStatePacket.Parser, new[]{ "IsPlaying", "OwnerId", "Board", "ActivePlayerId", "PlayerIds" }, null, null, null, null),
-            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.PlayCardRequest), global::TienLen.Gen.PlayCardRequest.Parser, new[]{ "CardIndices" }, null, null, null, null),
-            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.TurnUpdatePacket), global::TienLen.Gen.TurnUpdatePacket.Parser, new[]{ "ActivePlayerId", "LastPlayedCards", "SecondsRemaining" }, null, null, null, null)
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.MatchStatePacket), global::TienLen.Gen.MatchStatePacket.Parser, new[]{ "IsPlaying", "OwnerId", "Board", "ActivePlayerId", "PlayerIds", "Variant", "BotIds", "NextSeedHash", "PlayerCount", "HandSize", "OpeningCard", "SeatCount", "TurnDeadlineUnixMs", "TimeBankMs", "DisconnectedIds", "Phase", "PhaseDeadlineUnixMs", "ReadyIds", "Direction", "Config" }, null, null, null, new pbr::GeneratedClrTypeInfo[] { null, }),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.RoomConfig), global::TienLen.Gen.RoomConfig.Parser, new[]{ "Variant", "Seats", "HandSize", "DealAll", "Direction", "TurnSeconds", "TimeBankSeconds", "IncrementSeconds", "DisconnectGraceSeconds", "Stake", "Private", "HintsAllowed", "MaxSpectators", "AutoRestart", "HasPassword", "JoinCode" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.ReadyRequest), global::TienLen.Gen.ReadyRequest.Parser, new[]{ "Entropy" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.AddBotRequest), global::TienLen.Gen.AddBotRequest.Parser, new[]{ "Strategy" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.RemoveBotRequest), global::TienLen.Gen.RemoveBotRequest.Parser, new[]{ "BotId" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.PlayCardRequest), global::TienLen.Gen.PlayCardRequest.Parser, new[]{ "CardIndices", "Cards", "HandVersion" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.HintMove), global::TienLen.Gen.HintMove.Parser, new[]{ "CardIndices", "Cards" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.HintPacket), global::TienLen.Gen.HintPacket.Parser, new[]{ "Moves", "CanPass" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.TurnUpdatePacket), global::TienLen.Gen.TurnUpdatePacket.Parser, new[]{ "ActivePlayerId", "LastPlayedCards", "SecondsRemaining", "DeadlineUnixMs", "TimeBankMs" }, null, null, null, new pbr::GeneratedClrTypeInfo[] { null, }),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.TurnTimedOutPacket), global::TienLen.Gen.TurnTimedOutPacket.Parser, new[]{ "PlayerId", "Cards" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.GameState), global::TienLen.Gen.GameState.Parser, new[]{ "Version", "Variant", "Seed", "IsPlaying", "OwnerId", "Players", "TurnOrder", "CurrentIdx", "Hands", "HandVersions", "Deck", "Commitment", "Board", "LastActor", "RoundSkippers", "ChopChainOpen", "Winners", "FinishedPlayers", "Chops", "CardsPlayed", "EndedByInstantWin", "Log", "Deal", "HandSize", "OpeningCard", "Abandoned" }, null, null, null, new pbr::GeneratedClrTypeInfo[] { null, null, null, }),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.DealConfig), global::TienLen.Gen.DealConfig.Parser, new[]{ "HandSize", "DealAll", "Direction" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.LogEntry), global::TienLen.Gen.LogEntry.Parser, new[]{ "Seq", "Kind", "PlayerId", "Cards", "Variant", "Seed", "PlayerIds", "OwnerId", "LastWinnerId", "Commitment", "Deal" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::TienLen.Gen.CardList), global::TienLen.Gen.CardList.Parser, new[]{ "Cards" }, null, null, null, null)
           }));
     }
     #endregion
@@ -108,6 +202,38 @@ namespace TienLen.Gen {
     /// Server -> Client (Round finished, table cleared)
     /// </summary>
     [pbr::OriginalName("OP_ROUND_END")] OpRoundEnd = 11,
+    /// <summary>
+    /// Server -> Client (Dealt hand wins on the spot, hand revealed)
+    /// </summary>
+    [pbr::OriginalName("OP_INSTANT_WIN")] OpInstantWin = 12,
+    /// <summary>
+    /// Server -> Client (A bomb chopped 2s or another bomb)
+    /// </summary>
+    [pbr::OriginalName("OP_CHOP")] OpChop = 13,
+    /// <summary>
+    /// Client -> Server (Ask for suggested plays)
+    /// </summary>
+    [pbr::OriginalName("OP_HINT_REQUEST")] OpHintRequest = 14,
+    /// <summary>
+    /// Server -> Client (Suggested plays, best first)
+    /// </summary>
+    [pbr::OriginalName("OP_HINT")] OpHint = 15,
+    /// <summary>
+    /// Client -> Server (Owner seats a bot in a free seat)
+    /// </summary>
+    [pbr::OriginalName("OP_ADD_BOT")] OpAddBot = 16,
+    /// <summary>
+    /// Client -> Server (Owner removes a bot from its seat)
+    /// </summary>
+    [pbr::OriginalName("OP_REMOVE_BOT")] OpRemoveBot = 17,
+    /// <summary>
+    /// Server -> Client (A turn clock ran out and the server moved for the player)
+    /// </summary>
+    [pbr::OriginalName("OP_TURN_TIMED_OUT")] OpTurnTimedOut = 18,
+    /// <summary>
+    /// Client -> Server (Seated player is ready for the next game; ReadyRequest)
+    /// </summary>
+    [pbr::OriginalName("OP_READY")] OpReady = 19,
   }
 
   #endregion
@@ -393,6 +519,7 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public HandUpdatePacket(HandUpdatePacket other) : this() {
       hand_ = other.hand_.Clone();
+      version_ = other.version_;
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
@@ -413,6 +540,21 @@ namespace TienLen.Gen {
       get { return hand_; }
     }
 
+    /// <summary>Field number for the "version" field.</summary>
+    public const int VersionFieldNumber = 2;
+    private int version_;
+    /// <summary>
+    /// Increases every time the hand changes; echo it in PlayCardRequest.hand_version
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int Version {
+      get { return version_; }
+      set {
+        version_ = value;
+      }
+    }
+
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
@@ -429,6 +571,7 @@ namespace TienLen.Gen {
         return true;
       }
       if(!hand_.Equals(other.hand_)) return false;
+      if (Version != other.Version) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -437,6 +580,7 @@ namespace TienLen.Gen {
     public override int GetHashCode() {
       int hash = 1;
       hash ^= hand_.GetHashCode();
+      if (Version != 0) hash ^= Version.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -456,6 +600,10 @@ namespace TienLen.Gen {
       output.WriteRawMessage(this);
     #else
       hand_.WriteTo(output, _repeated_hand_codec);
+      if (Version != 0) {
+        output.WriteRawTag(16);
+        output.WriteInt32(Version);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -467,6 +615,10 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
       hand_.WriteTo(ref output, _repeated_hand_codec);
+      if (Version != 0) {
+        output.WriteRawTag(16);
+        output.WriteInt32(Version);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -478,6 +630,9 @@ namespace TienLen.Gen {
     public int CalculateSize() {
       int size = 0;
       size += hand_.CalculateSize(_repeated_hand_codec);
+      if (Version != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(Version);
+      }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -491,6 +646,9 @@ namespace TienLen.Gen {
         return;
       }
       hand_.Add(other.hand_);
+      if (other.Version != 0) {
+        Version = other.Version;
+      }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -514,6 +672,10 @@ namespace TienLen.Gen {
             hand_.AddEntriesFrom(input, _repeated_hand_codec);
             break;
           }
+          case 16: {
+            Version = input.ReadInt32();
+            break;
+          }
         }
       }
     #endif
@@ -537,6 +699,10 @@ namespace TienLen.Gen {
             hand_.AddEntriesFrom(ref input, _repeated_hand_codec);
             break;
           }
+          case 16: {
+            Version = input.ReadInt32();
+            break;
+          }
         }
       }
     }
@@ -582,6 +748,12 @@ namespace TienLen.Gen {
       hand_ = other.hand_.Clone();
       playerIds_ = other.playerIds_.Clone();
       ownerId_ = other.ownerId_;
+      dealCommitment_ = other.dealCommitment_;
+      clientEntropy_ = other.clientEntropy_;
+      handVersion_ = other.handVersion_;
+      handSize_ = other.handSize_;
+      openingCard_ = other.openingCard_ != null ? other.openingCard_.Clone() : null;
+      turnOrder_ = other.turnOrder_.Clone();
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
@@ -611,7 +783,7 @@ namespace TienLen.Gen {
         = pb::FieldCodec.ForString(18);
     private readonly pbc::RepeatedField<string> playerIds_ = new pbc::RepeatedField<string>();
     /// <summary>
-    /// Turn order
+    /// Players dealt in, by seat
     /// </summary>
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -634,6 +806,95 @@ namespace TienLen.Gen {
       }
     }
 
+    /// <summary>Field number for the "deal_commitment" field.</summary>
+    public const int DealCommitmentFieldNumber = 4;
+    private string dealCommitment_ = "";
+    /// <summary>
+    /// SHA-256 (hex) of the server seed and client entropy; revealed at game over
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string DealCommitment {
+      get { return dealCommitment_; }
+      set {
+        dealCommitment_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "client_entropy" field.</summary>
+    public const int ClientEntropyFieldNumber = 5;
+    private string clientEntropy_ = "";
+    /// <summary>
+    /// Entropy contributed by the players through join metadata or ReadyRequest
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string ClientEntropy {
+      get { return clientEntropy_; }
+      set {
+        clientEntropy_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "hand_version" field.</summary>
+    public const int HandVersionFieldNumber = 6;
+    private int handVersion_;
+    /// <summary>
+    /// Version of the dealt hand (see HandUpdatePacket.version)
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int HandVersion {
+      get { return handVersion_; }
+      set {
+        handVersion_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "hand_size" field.</summary>
+    public const int HandSizeFieldNumber = 7;
+    private int handSize_;
+    /// <summary>
+    /// Cards dealt to each player
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int HandSize {
+      get { return handSize_; }
+      set {
+        handSize_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "opening_card" field.</summary>
+    public const int OpeningCardFieldNumber = 8;
+    private global::TienLen.Gen.Card openingCard_;
+    /// <summary>
+    /// Small tables: the first play must include this card
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::TienLen.Gen.Card OpeningCard {
+      get { return openingCard_; }
+      set {
+        openingCard_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "turn_order" field.</summary>
+    public const int TurnOrderFieldNumber = 9;
+    private static readonly pb::FieldCodec<string> _repeated_turnOrder_codec
+        = pb::FieldCodec.ForString(74);
+    private readonly pbc::RepeatedField<string> turnOrder_ = new pbc::RepeatedField<string>();
+    /// <summary>
+    /// player_ids in the order turns are taken, from the first player
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> TurnOrder {
+      get { return turnOrder_; }
+    }
+
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
@@ -652,6 +913,12 @@ namespace TienLen.Gen {
       if(!hand_.Equals(other.hand_)) return false;
       if(!playerIds_.Equals(other.playerIds_)) return false;
       if (OwnerId != other.OwnerId) return false;
+      if (DealCommitment != other.DealCommitment) return false;
+      if (ClientEntropy != other.ClientEntropy) return false;
+      if (HandVersion != other.HandVersion) return false;
+      if (HandSize != other.HandSize) return false;
+      if (!object.Equals(OpeningCard, other.OpeningCard)) return false;
+      if(!turnOrder_.Equals(other.turnOrder_)) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -662,6 +929,12 @@ namespace TienLen.Gen {
       hash ^= hand_.GetHashCode();
       hash ^= playerIds_.GetHashCode();
       if (OwnerId.Length != 0) hash ^= OwnerId.GetHashCode();
+      if (DealCommitment.Length != 0) hash ^= DealCommitment.GetHashCode();
+      if (ClientEntropy.Length != 0) hash ^= ClientEntropy.GetHashCode();
+      if (HandVersion != 0) hash ^= HandVersion.GetHashCode();
+      if (HandSize != 0) hash ^= HandSize.GetHashCode();
+      if (openingCard_ != null) hash ^= OpeningCard.GetHashCode();
+      hash ^= turnOrder_.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -686,6 +959,27 @@ namespace TienLen.Gen {
         output.WriteRawTag(26);
         output.WriteString(OwnerId);
       }
+      if (DealCommitment.Length != 0) {
+        output.WriteRawTag(34);
+        output.WriteString(DealCommitment);
+      }
+      if (ClientEntropy.Length != 0) {
+        output.WriteRawTag(42);
+        output.WriteString(ClientEntropy);
+      }
+      if (HandVersion != 0) {
+        output.WriteRawTag(48);
+        output.WriteInt32(HandVersion);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(56);
+        output.WriteInt32(HandSize);
+      }
+      if (openingCard_ != null) {
+        output.WriteRawTag(66);
+        output.WriteMessage(OpeningCard);
+      }
+      turnOrder_.WriteTo(output, _repeated_turnOrder_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -702,6 +996,27 @@ namespace TienLen.Gen {
         output.WriteRawTag(26);
         output.WriteString(OwnerId);
       }
+      if (DealCommitment.Length != 0) {
+        output.WriteRawTag(34);
+        output.WriteString(DealCommitment);
+      }
+      if (ClientEntropy.Length != 0) {
+        output.WriteRawTag(42);
+        output.WriteString(ClientEntropy);
+      }
+      if (HandVersion != 0) {
+        output.WriteRawTag(48);
+        output.WriteInt32(HandVersion);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(56);
+        output.WriteInt32(HandSize);
+      }
+      if (openingCard_ != null) {
+        output.WriteRawTag(66);
+        output.WriteMessage(OpeningCard);
+      }
+      turnOrder_.WriteTo(ref output, _repeated_turnOrder_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -717,6 +1032,22 @@ namespace TienLen.Gen {
       if (OwnerId.Length != 0) {
         size += 1 + pb::CodedOutputStream.ComputeStringSize(OwnerId);
       }
+      if (DealCommitment.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(DealCommitment);
+      }
+      if (ClientEntropy.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(ClientEntropy);
+      }
+      if (HandVersion != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(HandVersion);
+      }
+      if (HandSize != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(HandSize);
+      }
+      if (openingCard_ != null) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(OpeningCard);
+      }
+      size += turnOrder_.CalculateSize(_repeated_turnOrder_codec);
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -734,6 +1065,25 @@ namespace TienLen.Gen {
       if (other.OwnerId.Length != 0) {
         OwnerId = other.OwnerId;
       }
+      if (other.DealCommitment.Length != 0) {
+        DealCommitment = other.DealCommitment;
+      }
+      if (other.ClientEntropy.Length != 0) {
+        ClientEntropy = other.ClientEntropy;
+      }
+      if (other.HandVersion != 0) {
+        HandVersion = other.HandVersion;
+      }
+      if (other.HandSize != 0) {
+        HandSize = other.HandSize;
+      }
+      if (other.openingCard_ != null) {
+        if (openingCard_ == null) {
+          OpeningCard = new global::TienLen.Gen.Card();
+        }
+        OpeningCard.MergeFrom(other.OpeningCard);
+      }
+      turnOrder_.Add(other.turnOrder_);
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -765,6 +1115,33 @@ namespace TienLen.Gen {
             OwnerId = input.ReadString();
             break;
           }
+          case 34: {
+            DealCommitment = input.ReadString();
+            break;
+          }
+          case 42: {
+            ClientEntropy = input.ReadString();
+            break;
+          }
+          case 48: {
+            HandVersion = input.ReadInt32();
+            break;
+          }
+          case 56: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 66: {
+            if (openingCard_ == null) {
+              OpeningCard = new global::TienLen.Gen.Card();
+            }
+            input.ReadMessage(OpeningCard);
+            break;
+          }
+          case 74: {
+            turnOrder_.AddEntriesFrom(input, _repeated_turnOrder_codec);
+            break;
+          }
         }
       }
     #endif
@@ -796,6 +1173,33 @@ namespace TienLen.Gen {
             OwnerId = input.ReadString();
             break;
           }
+          case 34: {
+            DealCommitment = input.ReadString();
+            break;
+          }
+          case 42: {
+            ClientEntropy = input.ReadString();
+            break;
+          }
+          case 48: {
+            HandVersion = input.ReadInt32();
+            break;
+          }
+          case 56: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 66: {
+            if (openingCard_ == null) {
+              OpeningCard = new global::TienLen.Gen.Card();
+            }
+            input.ReadMessage(OpeningCard);
+            break;
+          }
+          case 74: {
+            turnOrder_.AddEntriesFrom(ref input, _repeated_turnOrder_codec);
+            break;
+          }
         }
       }
     }
@@ -839,6 +1243,9 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public GameOverPacket(GameOverPacket other) : this() {
       winnerId_ = other.winnerId_;
+      standings_ = other.standings_.Clone();
+      results_ = other.results_.Clone();
+      deal_ = other.deal_ != null ? other.deal_.Clone() : null;
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
@@ -860,6 +1267,49 @@ namespace TienLen.Gen {
       }
     }
 
+    /// <summary>Field number for the "standings" field.</summary>
+    public const int StandingsFieldNumber = 2;
+    private static readonly pb::FieldCodec<string> _repeated_standings_codec
+        = pb::FieldCodec.ForString(18);
+    private readonly pbc::RepeatedField<string> standings_ = new pbc::RepeatedField<string>();
+    /// <summary>
+    /// Finishing order; the last entry lost
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> Standings {
+      get { return standings_; }
+    }
+
+    /// <summary>Field number for the "results" field.</summary>
+    public const int ResultsFieldNumber = 3;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.PlayerResult> _repeated_results_codec
+        = pb::FieldCodec.ForMessage(26, global::TienLen.Gen.PlayerResult.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.PlayerResult> results_ = new pbc::RepeatedField<global::TienLen.Gen.PlayerResult>();
+    /// <summary>
+    /// Settlement lines, in standings order
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.PlayerResult> Results {
+      get { return results_; }
+    }
+
+    /// <summary>Field number for the "deal" field.</summary>
+    public const int DealFieldNumber = 4;
+    private global::TienLen.Gen.DealReveal deal_;
+    /// <summary>
+    /// Reveals the committed deal so it can be verified
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::TienLen.Gen.DealReveal Deal {
+      get { return deal_; }
+      set {
+        deal_ = value;
+      }
+    }
+
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
@@ -876,6 +1326,9 @@ namespace TienLen.Gen {
         return true;
       }
       if (WinnerId != other.WinnerId) return false;
+      if(!standings_.Equals(other.standings_)) return false;
+      if(!results_.Equals(other.results_)) return false;
+      if (!object.Equals(Deal, other.Deal)) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -884,6 +1337,9 @@ namespace TienLen.Gen {
     public override int GetHashCode() {
       int hash = 1;
       if (WinnerId.Length != 0) hash ^= WinnerId.GetHashCode();
+      hash ^= standings_.GetHashCode();
+      hash ^= results_.GetHashCode();
+      if (deal_ != null) hash ^= Deal.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -906,6 +1362,12 @@ namespace TienLen.Gen {
         output.WriteRawTag(10);
         output.WriteString(WinnerId);
       }
+      standings_.WriteTo(output, _repeated_standings_codec);
+      results_.WriteTo(output, _repeated_results_codec);
+      if (deal_ != null) {
+        output.WriteRawTag(34);
+        output.WriteMessage(Deal);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -920,6 +1382,12 @@ namespace TienLen.Gen {
         output.WriteRawTag(10);
         output.WriteString(WinnerId);
       }
+      standings_.WriteTo(ref output, _repeated_standings_codec);
+      results_.WriteTo(ref output, _repeated_results_codec);
+      if (deal_ != null) {
+        output.WriteRawTag(34);
+        output.WriteMessage(Deal);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -933,6 +1401,11 @@ namespace TienLen.Gen {
       if (WinnerId.Length != 0) {
         size += 1 + pb::CodedOutputStream.ComputeStringSize(WinnerId);
       }
+      size += standings_.CalculateSize(_repeated_standings_codec);
+      size += results_.CalculateSize(_repeated_results_codec);
+      if (deal_ != null) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Deal);
+      }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -948,6 +1421,14 @@ namespace TienLen.Gen {
       if (other.WinnerId.Length != 0) {
         WinnerId = other.WinnerId;
       }
+      standings_.Add(other.standings_);
+      results_.Add(other.results_);
+      if (other.deal_ != null) {
+        if (deal_ == null) {
+          Deal = new global::TienLen.Gen.DealReveal();
+        }
+        Deal.MergeFrom(other.Deal);
+      }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -971,6 +1452,21 @@ namespace TienLen.Gen {
             WinnerId = input.ReadString();
             break;
           }
+          case 18: {
+            standings_.AddEntriesFrom(input, _repeated_standings_codec);
+            break;
+          }
+          case 26: {
+            results_.AddEntriesFrom(input, _repeated_results_codec);
+            break;
+          }
+          case 34: {
+            if (deal_ == null) {
+              Deal = new global::TienLen.Gen.DealReveal();
+            }
+            input.ReadMessage(Deal);
+            break;
+          }
         }
       }
     #endif
@@ -994,6 +1490,21 @@ namespace TienLen.Gen {
             WinnerId = input.ReadString();
             break;
           }
+          case 18: {
+            standings_.AddEntriesFrom(ref input, _repeated_standings_codec);
+            break;
+          }
+          case 26: {
+            results_.AddEntriesFrom(ref input, _repeated_results_codec);
+            break;
+          }
+          case 34: {
+            if (deal_ == null) {
+              Deal = new global::TienLen.Gen.DealReveal();
+            }
+            input.ReadMessage(Deal);
+            break;
+          }
         }
       }
     }
@@ -1002,16 +1513,16 @@ namespace TienLen.Gen {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class RoundEndPacket : pb::IMessage<RoundEndPacket>
+  public sealed partial class DealReveal : pb::IMessage<DealReveal>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<RoundEndPacket> _parser = new pb::MessageParser<RoundEndPacket>(() => new RoundEndPacket());
+    private static readonly pb::MessageParser<DealReveal> _parser = new pb::MessageParser<DealReveal>(() => new DealReveal());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<RoundEndPacket> Parser { get { return _parser; } }
+    public static pb::MessageParser<DealReveal> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -1027,7 +1538,7 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public RoundEndPacket() {
+    public DealReveal() {
       OnConstruction();
     }
 
@@ -1035,48 +1546,159 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public RoundEndPacket(RoundEndPacket other) : this() {
-      winnerId_ = other.winnerId_;
+    public DealReveal(DealReveal other) : this() {
+      serverSeed_ = other.serverSeed_;
+      clientEntropy_ = other.clientEntropy_;
+      commitment_ = other.commitment_;
+      playerIds_ = other.playerIds_.Clone();
+      deck_ = other.deck_.Clone();
+      seedHash_ = other.seedHash_;
+      handSize_ = other.handSize_;
+      direction_ = other.direction_;
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public RoundEndPacket Clone() {
-      return new RoundEndPacket(this);
+    public DealReveal Clone() {
+      return new DealReveal(this);
     }
 
-    /// <summary>Field number for the "winner_id" field.</summary>
-    public const int WinnerIdFieldNumber = 1;
-    private string winnerId_ = "";
+    /// <summary>Field number for the "server_seed" field.</summary>
+    public const int ServerSeedFieldNumber = 1;
+    private string serverSeed_ = "";
     /// <summary>
-    /// Player who wins this round
+    /// Hex; hashed with client_entropy it must equal the commitment
     /// </summary>
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public string WinnerId {
-      get { return winnerId_; }
+    public string ServerSeed {
+      get { return serverSeed_; }
       set {
-        winnerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+        serverSeed_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "client_entropy" field.</summary>
+    public const int ClientEntropyFieldNumber = 2;
+    private string clientEntropy_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string ClientEntropy {
+      get { return clientEntropy_; }
+      set {
+        clientEntropy_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "commitment" field.</summary>
+    public const int CommitmentFieldNumber = 3;
+    private string commitment_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Commitment {
+      get { return commitment_; }
+      set {
+        commitment_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "player_ids" field.</summary>
+    public const int PlayerIdsFieldNumber = 4;
+    private static readonly pb::FieldCodec<string> _repeated_playerIds_codec
+        = pb::FieldCodec.ForString(34);
+    private readonly pbc::RepeatedField<string> playerIds_ = new pbc::RepeatedField<string>();
+    /// <summary>
+    /// Player order the deal was computed from
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> PlayerIds {
+      get { return playerIds_; }
+    }
+
+    /// <summary>Field number for the "deck" field.</summary>
+    public const int DeckFieldNumber = 5;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_deck_codec
+        = pb::FieldCodec.ForMessage(42, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> deck_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>
+    /// Full deck order; hands are dealt in hand_size blocks in turn order
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Deck {
+      get { return deck_; }
+    }
+
+    /// <summary>Field number for the "seed_hash" field.</summary>
+    public const int SeedHashFieldNumber = 6;
+    private string seedHash_ = "";
+    /// <summary>
+    /// SHA-256 (hex) of server_seed, published as next_seed_hash before the deal
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string SeedHash {
+      get { return seedHash_; }
+      set {
+        seedHash_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "hand_size" field.</summary>
+    public const int HandSizeFieldNumber = 7;
+    private int handSize_;
+    /// <summary>
+    /// Cards dealt to each player
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int HandSize {
+      get { return handSize_; }
+      set {
+        handSize_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "direction" field.</summary>
+    public const int DirectionFieldNumber = 8;
+    private string direction_ = "";
+    /// <summary>
+    /// "counter_clockwise" or "clockwise", the way the deal went round
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Direction {
+      get { return direction_; }
+      set {
+        direction_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
       }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as RoundEndPacket);
+      return Equals(other as DealReveal);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(RoundEndPacket other) {
+    public bool Equals(DealReveal other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if (WinnerId != other.WinnerId) return false;
+      if (ServerSeed != other.ServerSeed) return false;
+      if (ClientEntropy != other.ClientEntropy) return false;
+      if (Commitment != other.Commitment) return false;
+      if(!playerIds_.Equals(other.playerIds_)) return false;
+      if(!deck_.Equals(other.deck_)) return false;
+      if (SeedHash != other.SeedHash) return false;
+      if (HandSize != other.HandSize) return false;
+      if (Direction != other.Direction) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -1084,7 +1706,14 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      if (WinnerId.Length != 0) hash ^= WinnerId.GetHashCode();
+      if (ServerSeed.Length != 0) hash ^= ServerSeed.GetHashCode();
+      if (ClientEntropy.Length != 0) hash ^= ClientEntropy.GetHashCode();
+      if (Commitment.Length != 0) hash ^= Commitment.GetHashCode();
+      hash ^= playerIds_.GetHashCode();
+      hash ^= deck_.GetHashCode();
+      if (SeedHash.Length != 0) hash ^= SeedHash.GetHashCode();
+      if (HandSize != 0) hash ^= HandSize.GetHashCode();
+      if (Direction.Length != 0) hash ^= Direction.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -1103,9 +1732,31 @@ namespace TienLen.Gen {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (WinnerId.Length != 0) {
+      if (ServerSeed.Length != 0) {
         output.WriteRawTag(10);
-        output.WriteString(WinnerId);
+        output.WriteString(ServerSeed);
+      }
+      if (ClientEntropy.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(ClientEntropy);
+      }
+      if (Commitment.Length != 0) {
+        output.WriteRawTag(26);
+        output.WriteString(Commitment);
+      }
+      playerIds_.WriteTo(output, _repeated_playerIds_codec);
+      deck_.WriteTo(output, _repeated_deck_codec);
+      if (SeedHash.Length != 0) {
+        output.WriteRawTag(50);
+        output.WriteString(SeedHash);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(56);
+        output.WriteInt32(HandSize);
+      }
+      if (Direction.Length != 0) {
+        output.WriteRawTag(66);
+        output.WriteString(Direction);
       }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
@@ -1117,9 +1768,31 @@ namespace TienLen.Gen {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      if (WinnerId.Length != 0) {
+      if (ServerSeed.Length != 0) {
         output.WriteRawTag(10);
-        output.WriteString(WinnerId);
+        output.WriteString(ServerSeed);
+      }
+      if (ClientEntropy.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(ClientEntropy);
+      }
+      if (Commitment.Length != 0) {
+        output.WriteRawTag(26);
+        output.WriteString(Commitment);
+      }
+      playerIds_.WriteTo(ref output, _repeated_playerIds_codec);
+      deck_.WriteTo(ref output, _repeated_deck_codec);
+      if (SeedHash.Length != 0) {
+        output.WriteRawTag(50);
+        output.WriteString(SeedHash);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(56);
+        output.WriteInt32(HandSize);
+      }
+      if (Direction.Length != 0) {
+        output.WriteRawTag(66);
+        output.WriteString(Direction);
       }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
@@ -1131,8 +1804,25 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      if (WinnerId.Length != 0) {
-        size += 1 + pb::CodedOutputStream.ComputeStringSize(WinnerId);
+      if (ServerSeed.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(ServerSeed);
+      }
+      if (ClientEntropy.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(ClientEntropy);
+      }
+      if (Commitment.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Commitment);
+      }
+      size += playerIds_.CalculateSize(_repeated_playerIds_codec);
+      size += deck_.CalculateSize(_repeated_deck_codec);
+      if (SeedHash.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(SeedHash);
+      }
+      if (HandSize != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(HandSize);
+      }
+      if (Direction.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Direction);
       }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
@@ -1142,12 +1832,29 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(RoundEndPacket other) {
+    public void MergeFrom(DealReveal other) {
       if (other == null) {
         return;
       }
-      if (other.WinnerId.Length != 0) {
-        WinnerId = other.WinnerId;
+      if (other.ServerSeed.Length != 0) {
+        ServerSeed = other.ServerSeed;
+      }
+      if (other.ClientEntropy.Length != 0) {
+        ClientEntropy = other.ClientEntropy;
+      }
+      if (other.Commitment.Length != 0) {
+        Commitment = other.Commitment;
+      }
+      playerIds_.Add(other.playerIds_);
+      deck_.Add(other.deck_);
+      if (other.SeedHash.Length != 0) {
+        SeedHash = other.SeedHash;
+      }
+      if (other.HandSize != 0) {
+        HandSize = other.HandSize;
+      }
+      if (other.Direction.Length != 0) {
+        Direction = other.Direction;
       }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
@@ -1169,7 +1876,35 @@ namespace TienLen.Gen {
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
           case 10: {
-            WinnerId = input.ReadString();
+            ServerSeed = input.ReadString();
+            break;
+          }
+          case 18: {
+            ClientEntropy = input.ReadString();
+            break;
+          }
+          case 26: {
+            Commitment = input.ReadString();
+            break;
+          }
+          case 34: {
+            playerIds_.AddEntriesFrom(input, _repeated_playerIds_codec);
+            break;
+          }
+          case 42: {
+            deck_.AddEntriesFrom(input, _repeated_deck_codec);
+            break;
+          }
+          case 50: {
+            SeedHash = input.ReadString();
+            break;
+          }
+          case 56: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 66: {
+            Direction = input.ReadString();
             break;
           }
         }
@@ -1192,7 +1927,35 @@ namespace TienLen.Gen {
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
           case 10: {
-            WinnerId = input.ReadString();
+            ServerSeed = input.ReadString();
+            break;
+          }
+          case 18: {
+            ClientEntropy = input.ReadString();
+            break;
+          }
+          case 26: {
+            Commitment = input.ReadString();
+            break;
+          }
+          case 34: {
+            playerIds_.AddEntriesFrom(ref input, _repeated_playerIds_codec);
+            break;
+          }
+          case 42: {
+            deck_.AddEntriesFrom(ref input, _repeated_deck_codec);
+            break;
+          }
+          case 50: {
+            SeedHash = input.ReadString();
+            break;
+          }
+          case 56: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 66: {
+            Direction = input.ReadString();
             break;
           }
         }
@@ -1203,16 +1966,16 @@ namespace TienLen.Gen {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class MatchStatePacket : pb::IMessage<MatchStatePacket>
+  public sealed partial class PlayerResult : pb::IMessage<PlayerResult>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<MatchStatePacket> _parser = new pb::MessageParser<MatchStatePacket>(() => new MatchStatePacket());
+    private static readonly pb::MessageParser<PlayerResult> _parser = new pb::MessageParser<PlayerResult>(() => new PlayerResult());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<MatchStatePacket> Parser { get { return _parser; } }
+    public static pb::MessageParser<PlayerResult> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -1228,7 +1991,7 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public MatchStatePacket() {
+    public PlayerResult() {
       OnConstruction();
     }
 
@@ -1236,102 +1999,185 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public MatchStatePacket(MatchStatePacket other) : this() {
-      isPlaying_ = other.isPlaying_;
-      ownerId_ = other.ownerId_;
-      board_ = other.board_.Clone();
-      activePlayerId_ = other.activePlayerId_;
-      playerIds_ = other.playerIds_.Clone();
+    public PlayerResult(PlayerResult other) : this() {
+      playerId_ = other.playerId_;
+      place_ = other.place_;
+      cong_ = other.cong_;
+      remainingHand_ = other.remainingHand_.Clone();
+      placementPoints_ = other.placementPoints_;
+      congPoints_ = other.congPoints_;
+      leftoverPoints_ = other.leftoverPoints_;
+      chopPoints_ = other.chopPoints_;
+      total_ = other.total_;
+      abandoned_ = other.abandoned_;
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public MatchStatePacket Clone() {
-      return new MatchStatePacket(this);
+    public PlayerResult Clone() {
+      return new PlayerResult(this);
     }
 
-    /// <summary>Field number for the "is_playing" field.</summary>
-    public const int IsPlayingFieldNumber = 1;
-    private bool isPlaying_;
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private string playerId_ = "";
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool IsPlaying {
-      get { return isPlaying_; }
+    public string PlayerId {
+      get { return playerId_; }
       set {
-        isPlaying_ = value;
+        playerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
       }
     }
 
-    /// <summary>Field number for the "owner_id" field.</summary>
-    public const int OwnerIdFieldNumber = 2;
-    private string ownerId_ = "";
+    /// <summary>Field number for the "place" field.</summary>
+    public const int PlaceFieldNumber = 2;
+    private int place_;
+    /// <summary>
+    /// 1-based finishing position
+    /// </summary>
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public string OwnerId {
-      get { return ownerId_; }
+    public int Place {
+      get { return place_; }
       set {
-        ownerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+        place_ = value;
       }
     }
 
-    /// <summary>Field number for the "board" field.</summary>
-    public const int BoardFieldNumber = 3;
-    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_board_codec
-        = pb::FieldCodec.ForMessage(26, global::TienLen.Gen.Card.Parser);
-    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> board_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>Field number for the "cong" field.</summary>
+    public const int CongFieldNumber = 3;
+    private bool cong_;
+    /// <summary>
+    /// Never played a card
+    /// </summary>
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public pbc::RepeatedField<global::TienLen.Gen.Card> Board {
-      get { return board_; }
+    public bool Cong {
+      get { return cong_; }
+      set {
+        cong_ = value;
+      }
     }
 
-    /// <summary>Field number for the "active_player_id" field.</summary>
-    public const int ActivePlayerIdFieldNumber = 4;
-    private string activePlayerId_ = "";
+    /// <summary>Field number for the "remaining_hand" field.</summary>
+    public const int RemainingHandFieldNumber = 4;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_remainingHand_codec
+        = pb::FieldCodec.ForMessage(34, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> remainingHand_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>
+    /// Cards left when the game ended
+    /// </summary>
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public string ActivePlayerId {
-      get { return activePlayerId_; }
+    public pbc::RepeatedField<global::TienLen.Gen.Card> RemainingHand {
+      get { return remainingHand_; }
+    }
+
+    /// <summary>Field number for the "placement_points" field.</summary>
+    public const int PlacementPointsFieldNumber = 5;
+    private int placementPoints_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int PlacementPoints {
+      get { return placementPoints_; }
       set {
-        activePlayerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+        placementPoints_ = value;
       }
     }
 
-    /// <summary>Field number for the "player_ids" field.</summary>
-    public const int PlayerIdsFieldNumber = 5;
-    private static readonly pb::FieldCodec<string> _repeated_playerIds_codec
-        = pb::FieldCodec.ForString(42);
-    private readonly pbc::RepeatedField<string> playerIds_ = new pbc::RepeatedField<string>();
+    /// <summary>Field number for the "cong_points" field.</summary>
+    public const int CongPointsFieldNumber = 6;
+    private int congPoints_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CongPoints {
+      get { return congPoints_; }
+      set {
+        congPoints_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "leftover_points" field.</summary>
+    public const int LeftoverPointsFieldNumber = 7;
+    private int leftoverPoints_;
     /// <summary>
-    /// Who is currently playing
+    /// Leftover 2s and bombs (thoi heo, thoi bom)
     /// </summary>
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public pbc::RepeatedField<string> PlayerIds {
-      get { return playerIds_; }
+    public int LeftoverPoints {
+      get { return leftoverPoints_; }
+      set {
+        leftoverPoints_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "chop_points" field.</summary>
+    public const int ChopPointsFieldNumber = 8;
+    private int chopPoints_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int ChopPoints {
+      get { return chopPoints_; }
+      set {
+        chopPoints_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "total" field.</summary>
+    public const int TotalFieldNumber = 9;
+    private int total_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int Total {
+      get { return total_; }
+      set {
+        total_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "abandoned" field.</summary>
+    public const int AbandonedFieldNumber = 10;
+    private bool abandoned_;
+    /// <summary>
+    /// Left mid-game; a server bot played the rest of the hand
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Abandoned {
+      get { return abandoned_; }
+      set {
+        abandoned_ = value;
+      }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as MatchStatePacket);
+      return Equals(other as PlayerResult);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(MatchStatePacket other) {
+    public bool Equals(PlayerResult other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if (IsPlaying != other.IsPlaying) return false;
-      if (OwnerId != other.OwnerId) return false;
-      if(!board_.Equals(other.board_)) return false;
-      if (ActivePlayerId != other.ActivePlayerId) return false;
-      if(!playerIds_.Equals(other.playerIds_)) return false;
+      if (PlayerId != other.PlayerId) return false;
+      if (Place != other.Place) return false;
+      if (Cong != other.Cong) return false;
+      if(!remainingHand_.Equals(other.remainingHand_)) return false;
+      if (PlacementPoints != other.PlacementPoints) return false;
+      if (CongPoints != other.CongPoints) return false;
+      if (LeftoverPoints != other.LeftoverPoints) return false;
+      if (ChopPoints != other.ChopPoints) return false;
+      if (Total != other.Total) return false;
+      if (Abandoned != other.Abandoned) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -1339,11 +2185,16 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      if (IsPlaying != false) hash ^= IsPlaying.GetHashCode();
-      if (OwnerId.Length != 0) hash ^= OwnerId.GetHashCode();
-      hash ^= board_.GetHashCode();
-      if (ActivePlayerId.Length != 0) hash ^= ActivePlayerId.GetHashCode();
-      hash ^= playerIds_.GetHashCode();
+      if (PlayerId.Length != 0) hash ^= PlayerId.GetHashCode();
+      if (Place != 0) hash ^= Place.GetHashCode();
+      if (Cong != false) hash ^= Cong.GetHashCode();
+      hash ^= remainingHand_.GetHashCode();
+      if (PlacementPoints != 0) hash ^= PlacementPoints.GetHashCode();
+      if (CongPoints != 0) hash ^= CongPoints.GetHashCode();
+      if (LeftoverPoints != 0) hash ^= LeftoverPoints.GetHashCode();
+      if (ChopPoints != 0) hash ^= ChopPoints.GetHashCode();
+      if (Total != 0) hash ^= Total.GetHashCode();
+      if (Abandoned != false) hash ^= Abandoned.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -1362,20 +2213,43 @@ namespace TienLen.Gen {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (IsPlaying != false) {
-        output.WriteRawTag(8);
-        output.WriteBool(IsPlaying);
+      if (PlayerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(PlayerId);
       }
-      if (OwnerId.Length != 0) {
-        output.WriteRawTag(18);
-        output.WriteString(OwnerId);
+      if (Place != 0) {
+        output.WriteRawTag(16);
+        output.WriteInt32(Place);
       }
-      board_.WriteTo(output, _repeated_board_codec);
-      if (ActivePlayerId.Length != 0) {
-        output.WriteRawTag(34);
-        output.WriteString(ActivePlayerId);
+      if (Cong != false) {
+        output.WriteRawTag(24);
+        output.WriteBool(Cong);
+      }
+      remainingHand_.WriteTo(output, _repeated_remainingHand_codec);
+      if (PlacementPoints != 0) {
+        output.WriteRawTag(40);
+        output.WriteInt32(PlacementPoints);
+      }
+      if (CongPoints != 0) {
+        output.WriteRawTag(48);
+        output.WriteInt32(CongPoints);
+      }
+      if (LeftoverPoints != 0) {
+        output.WriteRawTag(56);
+        output.WriteInt32(LeftoverPoints);
+      }
+      if (ChopPoints != 0) {
+        output.WriteRawTag(64);
+        output.WriteInt32(ChopPoints);
+      }
+      if (Total != 0) {
+        output.WriteRawTag(72);
+        output.WriteInt32(Total);
+      }
+      if (Abandoned != false) {
+        output.WriteRawTag(80);
+        output.WriteBool(Abandoned);
       }
-      playerIds_.WriteTo(output, _repeated_playerIds_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -1386,20 +2260,43 @@ namespace TienLen.Gen {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      if (IsPlaying != false) {
-        output.WriteRawTag(8);
-        output.WriteBool(IsPlaying);
+      if (PlayerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(PlayerId);
       }
-      if (OwnerId.Length != 0) {
-        output.WriteRawTag(18);
-        output.WriteString(OwnerId);
+      if (Place != 0) {
+        output.WriteRawTag(16);
+        output.WriteInt32(Place);
       }
-      board_.WriteTo(ref output, _repeated_board_codec);
-      if (ActivePlayerId.Length != 0) {
-        output.WriteRawTag(34);
-        output.WriteString(ActivePlayerId);
+      if (Cong != false) {
+        output.WriteRawTag(24);
+        output.WriteBool(Cong);
+      }
+      remainingHand_.WriteTo(ref output, _repeated_remainingHand_codec);
+      if (PlacementPoints != 0) {
+        output.WriteRawTag(40);
+        output.WriteInt32(PlacementPoints);
+      }
+      if (CongPoints != 0) {
+        output.WriteRawTag(48);
+        output.WriteInt32(CongPoints);
+      }
+      if (LeftoverPoints != 0) {
+        output.WriteRawTag(56);
+        output.WriteInt32(LeftoverPoints);
+      }
+      if (ChopPoints != 0) {
+        output.WriteRawTag(64);
+        output.WriteInt32(ChopPoints);
+      }
+      if (Total != 0) {
+        output.WriteRawTag(72);
+        output.WriteInt32(Total);
+      }
+      if (Abandoned != false) {
+        output.WriteRawTag(80);
+        output.WriteBool(Abandoned);
       }
-      playerIds_.WriteTo(ref output, _repeated_playerIds_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -1410,17 +2307,34 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      if (IsPlaying != false) {
+      if (PlayerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(PlayerId);
+      }
+      if (Place != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(Place);
+      }
+      if (Cong != false) {
         size += 1 + 1;
       }
-      if (OwnerId.Length != 0) {
-        size += 1 + pb::CodedOutputStream.ComputeStringSize(OwnerId);
+      size += remainingHand_.CalculateSize(_repeated_remainingHand_codec);
+      if (PlacementPoints != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(PlacementPoints);
       }
-      size += board_.CalculateSize(_repeated_board_codec);
-      if (ActivePlayerId.Length != 0) {
-        size += 1 + pb::CodedOutputStream.ComputeStringSize(ActivePlayerId);
+      if (CongPoints != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(CongPoints);
+      }
+      if (LeftoverPoints != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(LeftoverPoints);
+      }
+      if (ChopPoints != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(ChopPoints);
+      }
+      if (Total != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(Total);
+      }
+      if (Abandoned != false) {
+        size += 1 + 1;
       }
-      size += playerIds_.CalculateSize(_repeated_playerIds_codec);
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -1429,21 +2343,38 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(MatchStatePacket other) {
+    public void MergeFrom(PlayerResult other) {
       if (other == null) {
         return;
       }
-      if (other.IsPlaying != false) {
-        IsPlaying = other.IsPlaying;
+      if (other.PlayerId.Length != 0) {
+        PlayerId = other.PlayerId;
       }
-      if (other.OwnerId.Length != 0) {
-        OwnerId = other.OwnerId;
+      if (other.Place != 0) {
+        Place = other.Place;
       }
-      board_.Add(other.board_);
-      if (other.ActivePlayerId.Length != 0) {
-        ActivePlayerId = other.ActivePlayerId;
+      if (other.Cong != false) {
+        Cong = other.Cong;
+      }
+      remainingHand_.Add(other.remainingHand_);
+      if (other.PlacementPoints != 0) {
+        PlacementPoints = other.PlacementPoints;
+      }
+      if (other.CongPoints != 0) {
+        CongPoints = other.CongPoints;
+      }
+      if (other.LeftoverPoints != 0) {
+        LeftoverPoints = other.LeftoverPoints;
+      }
+      if (other.ChopPoints != 0) {
+        ChopPoints = other.ChopPoints;
+      }
+      if (other.Total != 0) {
+        Total = other.Total;
+      }
+      if (other.Abandoned != false) {
+        Abandoned = other.Abandoned;
       }
-      playerIds_.Add(other.playerIds_);
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -1463,30 +2394,50 @@ namespace TienLen.Gen {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
-          case 8: {
-            IsPlaying = input.ReadBool();
+          case 10: {
+            PlayerId = input.ReadString();
             break;
           }
-          case 18: {
-            OwnerId = input.ReadString();
+          case 16: {
+            Place = input.ReadInt32();
             break;
           }
-          case 26: {
-            board_.AddEntriesFrom(input, _repeated_board_codec);
+          case 24: {
+            Cong = input.ReadBool();
             break;
           }
           case 34: {
-            ActivePlayerId = input.ReadString();
+            remainingHand_.AddEntriesFrom(input, _repeated_remainingHand_codec);
             break;
           }
-          case 42: {
-            playerIds_.AddEntriesFrom(input, _repeated_playerIds_codec);
+          case 40: {
+            PlacementPoints = input.ReadInt32();
             break;
           }
-        }
-      }
-    #endif
-    }
+          case 48: {
+            CongPoints = input.ReadInt32();
+            break;
+          }
+          case 56: {
+            LeftoverPoints = input.ReadInt32();
+            break;
+          }
+          case 64: {
+            ChopPoints = input.ReadInt32();
+            break;
+          }
+          case 72: {
+            Total = input.ReadInt32();
+            break;
+          }
+          case 80: {
+            Abandoned = input.ReadBool();
+            break;
+          }
+        }
+      }
+    #endif
+    }
 
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
@@ -1502,24 +2453,44 @@ namespace TienLen.Gen {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
-          case 8: {
-            IsPlaying = input.ReadBool();
+          case 10: {
+            PlayerId = input.ReadString();
             break;
           }
-          case 18: {
-            OwnerId = input.ReadString();
+          case 16: {
+            Place = input.ReadInt32();
             break;
           }
-          case 26: {
-            board_.AddEntriesFrom(ref input, _repeated_board_codec);
+          case 24: {
+            Cong = input.ReadBool();
             break;
           }
           case 34: {
-            ActivePlayerId = input.ReadString();
+            remainingHand_.AddEntriesFrom(ref input, _repeated_remainingHand_codec);
             break;
           }
-          case 42: {
-            playerIds_.AddEntriesFrom(ref input, _repeated_playerIds_codec);
+          case 40: {
+            PlacementPoints = input.ReadInt32();
+            break;
+          }
+          case 48: {
+            CongPoints = input.ReadInt32();
+            break;
+          }
+          case 56: {
+            LeftoverPoints = input.ReadInt32();
+            break;
+          }
+          case 64: {
+            ChopPoints = input.ReadInt32();
+            break;
+          }
+          case 72: {
+            Total = input.ReadInt32();
+            break;
+          }
+          case 80: {
+            Abandoned = input.ReadBool();
             break;
           }
         }
@@ -1530,16 +2501,16 @@ namespace TienLen.Gen {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class PlayCardRequest : pb::IMessage<PlayCardRequest>
+  public sealed partial class InstantWinPacket : pb::IMessage<InstantWinPacket>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<PlayCardRequest> _parser = new pb::MessageParser<PlayCardRequest>(() => new PlayCardRequest());
+    private static readonly pb::MessageParser<InstantWinPacket> _parser = new pb::MessageParser<InstantWinPacket>(() => new InstantWinPacket());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<PlayCardRequest> Parser { get { return _parser; } }
+    public static pb::MessageParser<InstantWinPacket> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -1555,55 +2526,5914 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public PlayCardRequest() {
+    public InstantWinPacket() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public InstantWinPacket(InstantWinPacket other) : this() {
+      playerId_ = other.playerId_;
+      pattern_ = other.pattern_;
+      hand_ = other.hand_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public InstantWinPacket Clone() {
+      return new InstantWinPacket(this);
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private string playerId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "pattern" field.</summary>
+    public const int PatternFieldNumber = 2;
+    private string pattern_ = "";
+    /// <summary>
+    /// "dragon", "four_twos", "five_consecutive_pairs", "six_pairs"
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Pattern {
+      get { return pattern_; }
+      set {
+        pattern_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "hand" field.</summary>
+    public const int HandFieldNumber = 3;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_hand_codec
+        = pb::FieldCodec.ForMessage(26, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> hand_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>
+    /// The winning hand, revealed to everyone
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Hand {
+      get { return hand_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as InstantWinPacket);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(InstantWinPacket other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (PlayerId != other.PlayerId) return false;
+      if (Pattern != other.Pattern) return false;
+      if(!hand_.Equals(other.hand_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (PlayerId.Length != 0) hash ^= PlayerId.GetHashCode();
+      if (Pattern.Length != 0) hash ^= Pattern.GetHashCode();
+      hash ^= hand_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (PlayerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(PlayerId);
+      }
+      if (Pattern.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Pattern);
+      }
+      hand_.WriteTo(output, _repeated_hand_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (PlayerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(PlayerId);
+      }
+      if (Pattern.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Pattern);
+      }
+      hand_.WriteTo(ref output, _repeated_hand_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (PlayerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(PlayerId);
+      }
+      if (Pattern.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Pattern);
+      }
+      size += hand_.CalculateSize(_repeated_hand_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(InstantWinPacket other) {
+      if (other == null) {
+        return;
+      }
+      if (other.PlayerId.Length != 0) {
+        PlayerId = other.PlayerId;
+      }
+      if (other.Pattern.Length != 0) {
+        Pattern = other.Pattern;
+      }
+      hand_.Add(other.hand_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            PlayerId = input.ReadString();
+            break;
+          }
+          case 18: {
+            Pattern = input.ReadString();
+            break;
+          }
+          case 26: {
+            hand_.AddEntriesFrom(input, _repeated_hand_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            PlayerId = input.ReadString();
+            break;
+          }
+          case 18: {
+            Pattern = input.ReadString();
+            break;
+          }
+          case 26: {
+            hand_.AddEntriesFrom(ref input, _repeated_hand_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class ChopPacket : pb::IMessage<ChopPacket>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<ChopPacket> _parser = new pb::MessageParser<ChopPacket>(() => new ChopPacket());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<ChopPacket> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[7]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ChopPacket() {
       OnConstruction();
     }
 
-    partial void OnConstruction();
-
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ChopPacket(ChopPacket other) : this() {
+      chopperId_ = other.chopperId_;
+      victimId_ = other.victimId_;
+      choppedCards_ = other.choppedCards_.Clone();
+      bombCards_ = other.bombCards_.Clone();
+      penalty_ = other.penalty_;
+      chain_ = other.chain_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ChopPacket Clone() {
+      return new ChopPacket(this);
+    }
+
+    /// <summary>Field number for the "chopper_id" field.</summary>
+    public const int ChopperIdFieldNumber = 1;
+    private string chopperId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string ChopperId {
+      get { return chopperId_; }
+      set {
+        chopperId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "victim_id" field.</summary>
+    public const int VictimIdFieldNumber = 2;
+    private string victimId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string VictimId {
+      get { return victimId_; }
+      set {
+        victimId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "chopped_cards" field.</summary>
+    public const int ChoppedCardsFieldNumber = 3;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_choppedCards_codec
+        = pb::FieldCodec.ForMessage(26, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> choppedCards_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>
+    /// Cards that were chopped (2s or a bomb)
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> ChoppedCards {
+      get { return choppedCards_; }
+    }
+
+    /// <summary>Field number for the "bomb_cards" field.</summary>
+    public const int BombCardsFieldNumber = 4;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_bombCards_codec
+        = pb::FieldCodec.ForMessage(34, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> bombCards_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>
+    /// Cards the chopper played
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> BombCards {
+      get { return bombCards_; }
+    }
+
+    /// <summary>Field number for the "penalty" field.</summary>
+    public const int PenaltyFieldNumber = 5;
+    private int penalty_;
+    /// <summary>
+    /// Accumulated penalty owed by the victim
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int Penalty {
+      get { return penalty_; }
+      set {
+        penalty_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "chain" field.</summary>
+    public const int ChainFieldNumber = 6;
+    private int chain_;
+    /// <summary>
+    /// 1 for a first chop, 2+ for over-chops (chat chong)
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int Chain {
+      get { return chain_; }
+      set {
+        chain_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as ChopPacket);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(ChopPacket other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (ChopperId != other.ChopperId) return false;
+      if (VictimId != other.VictimId) return false;
+      if(!choppedCards_.Equals(other.choppedCards_)) return false;
+      if(!bombCards_.Equals(other.bombCards_)) return false;
+      if (Penalty != other.Penalty) return false;
+      if (Chain != other.Chain) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (ChopperId.Length != 0) hash ^= ChopperId.GetHashCode();
+      if (VictimId.Length != 0) hash ^= VictimId.GetHashCode();
+      hash ^= choppedCards_.GetHashCode();
+      hash ^= bombCards_.GetHashCode();
+      if (Penalty != 0) hash ^= Penalty.GetHashCode();
+      if (Chain != 0) hash ^= Chain.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (ChopperId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(ChopperId);
+      }
+      if (VictimId.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(VictimId);
+      }
+      choppedCards_.WriteTo(output, _repeated_choppedCards_codec);
+      bombCards_.WriteTo(output, _repeated_bombCards_codec);
+      if (Penalty != 0) {
+        output.WriteRawTag(40);
+        output.WriteInt32(Penalty);
+      }
+      if (Chain != 0) {
+        output.WriteRawTag(48);
+        output.WriteInt32(Chain);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (ChopperId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(ChopperId);
+      }
+      if (VictimId.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(VictimId);
+      }
+      choppedCards_.WriteTo(ref output, _repeated_choppedCards_codec);
+      bombCards_.WriteTo(ref output, _repeated_bombCards_codec);
+      if (Penalty != 0) {
+        output.WriteRawTag(40);
+        output.WriteInt32(Penalty);
+      }
+      if (Chain != 0) {
+        output.WriteRawTag(48);
+        output.WriteInt32(Chain);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (ChopperId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(ChopperId);
+      }
+      if (VictimId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(VictimId);
+      }
+      size += choppedCards_.CalculateSize(_repeated_choppedCards_codec);
+      size += bombCards_.CalculateSize(_repeated_bombCards_codec);
+      if (Penalty != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(Penalty);
+      }
+      if (Chain != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(Chain);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(ChopPacket other) {
+      if (other == null) {
+        return;
+      }
+      if (other.ChopperId.Length != 0) {
+        ChopperId = other.ChopperId;
+      }
+      if (other.VictimId.Length != 0) {
+        VictimId = other.VictimId;
+      }
+      choppedCards_.Add(other.choppedCards_);
+      bombCards_.Add(other.bombCards_);
+      if (other.Penalty != 0) {
+        Penalty = other.Penalty;
+      }
+      if (other.Chain != 0) {
+        Chain = other.Chain;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            ChopperId = input.ReadString();
+            break;
+          }
+          case 18: {
+            VictimId = input.ReadString();
+            break;
+          }
+          case 26: {
+            choppedCards_.AddEntriesFrom(input, _repeated_choppedCards_codec);
+            break;
+          }
+          case 34: {
+            bombCards_.AddEntriesFrom(input, _repeated_bombCards_codec);
+            break;
+          }
+          case 40: {
+            Penalty = input.ReadInt32();
+            break;
+          }
+          case 48: {
+            Chain = input.ReadInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            ChopperId = input.ReadString();
+            break;
+          }
+          case 18: {
+            VictimId = input.ReadString();
+            break;
+          }
+          case 26: {
+            choppedCards_.AddEntriesFrom(ref input, _repeated_choppedCards_codec);
+            break;
+          }
+          case 34: {
+            bombCards_.AddEntriesFrom(ref input, _repeated_bombCards_codec);
+            break;
+          }
+          case 40: {
+            Penalty = input.ReadInt32();
+            break;
+          }
+          case 48: {
+            Chain = input.ReadInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RoundEndPacket : pb::IMessage<RoundEndPacket>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RoundEndPacket> _parser = new pb::MessageParser<RoundEndPacket>(() => new RoundEndPacket());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RoundEndPacket> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[8]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundEndPacket() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundEndPacket(RoundEndPacket other) : this() {
+      winnerId_ = other.winnerId_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundEndPacket Clone() {
+      return new RoundEndPacket(this);
+    }
+
+    /// <summary>Field number for the "winner_id" field.</summary>
+    public const int WinnerIdFieldNumber = 1;
+    private string winnerId_ = "";
+    /// <summary>
+    /// Player who wins this round
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string WinnerId {
+      get { return winnerId_; }
+      set {
+        winnerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RoundEndPacket);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RoundEndPacket other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (WinnerId != other.WinnerId) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (WinnerId.Length != 0) hash ^= WinnerId.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (WinnerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(WinnerId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (WinnerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(WinnerId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (WinnerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(WinnerId);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RoundEndPacket other) {
+      if (other == null) {
+        return;
+      }
+      if (other.WinnerId.Length != 0) {
+        WinnerId = other.WinnerId;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            WinnerId = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            WinnerId = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class MatchStatePacket : pb::IMessage<MatchStatePacket>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<MatchStatePacket> _parser = new pb::MessageParser<MatchStatePacket>(() => new MatchStatePacket());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<MatchStatePacket> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[9]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public MatchStatePacket() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public MatchStatePacket(MatchStatePacket other) : this() {
+      isPlaying_ = other.isPlaying_;
+      ownerId_ = other.ownerId_;
+      board_ = other.board_.Clone();
+      activePlayerId_ = other.activePlayerId_;
+      playerIds_ = other.playerIds_.Clone();
+      variant_ = other.variant_;
+      botIds_ = other.botIds_.Clone();
+      nextSeedHash_ = other.nextSeedHash_;
+      playerCount_ = other.playerCount_;
+      handSize_ = other.handSize_;
+      openingCard_ = other.openingCard_ != null ? other.openingCard_.Clone() : null;
+      seatCount_ = other.seatCount_;
+      turnDeadlineUnixMs_ = other.turnDeadlineUnixMs_;
+      timeBankMs_ = other.timeBankMs_.Clone();
+      disconnectedIds_ = other.disconnectedIds_.Clone();
+      phase_ = other.phase_;
+      phaseDeadlineUnixMs_ = other.phaseDeadlineUnixMs_;
+      readyIds_ = other.readyIds_.Clone();
+      direction_ = other.direction_;
+      config_ = other.config_ != null ? other.config_.Clone() : null;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public MatchStatePacket Clone() {
+      return new MatchStatePacket(this);
+    }
+
+    /// <summary>Field number for the "is_playing" field.</summary>
+    public const int IsPlayingFieldNumber = 1;
+    private bool isPlaying_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool IsPlaying {
+      get { return isPlaying_; }
+      set {
+        isPlaying_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "owner_id" field.</summary>
+    public const int OwnerIdFieldNumber = 2;
+    private string ownerId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string OwnerId {
+      get { return ownerId_; }
+      set {
+        ownerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "board" field.</summary>
+    public const int BoardFieldNumber = 3;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_board_codec
+        = pb::FieldCodec.ForMessage(26, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> board_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Board {
+      get { return board_; }
+    }
+
+    /// <summary>Field number for the "active_player_id" field.</summary>
+    public const int ActivePlayerIdFieldNumber = 4;
+    private string activePlayerId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string ActivePlayerId {
+      get { return activePlayerId_; }
+      set {
+        activePlayerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "player_ids" field.</summary>
+    public const int PlayerIdsFieldNumber = 5;
+    private static readonly pb::FieldCodec<string> _repeated_playerIds_codec
+        = pb::FieldCodec.ForString(42);
+    private readonly pbc::RepeatedField<string> playerIds_ = new pbc::RepeatedField<string>();
+    /// <summary>
+    /// Who is currently playing, by seat; empty string for a free seat
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> PlayerIds {
+      get { return playerIds_; }
+    }
+
+    /// <summary>Field number for the "variant" field.</summary>
+    public const int VariantFieldNumber = 6;
+    private string variant_ = "";
+    /// <summary>
+    /// Rule set in use ("southern", "northern")
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Variant {
+      get { return variant_; }
+      set {
+        variant_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "bot_ids" field.</summary>
+    public const int BotIdsFieldNumber = 7;
+    private static readonly pb::FieldCodec<string> _repeated_botIds_codec
+        = pb::FieldCodec.ForString(58);
+    private readonly pbc::RepeatedField<string> botIds_ = new pbc::RepeatedField<string>();
+    /// <summary>
+    /// Seated players controlled by the server
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> BotIds {
+      get { return botIds_; }
+    }
+
+    /// <summary>Field number for the "next_seed_hash" field.</summary>
+    public const int NextSeedHashFieldNumber = 8;
+    private string nextSeedHash_ = "";
+    /// <summary>
+    /// SHA-256 (hex) of the server seed of the next deal; only entropy sent after it counts
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string NextSeedHash {
+      get { return nextSeedHash_; }
+      set {
+        nextSeedHash_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "player_count" field.</summary>
+    public const int PlayerCountFieldNumber = 9;
+    private int playerCount_;
+    /// <summary>
+    /// Players dealt in; 2 or 3 is a small table with the opening card rule
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int PlayerCount {
+      get { return playerCount_; }
+      set {
+        playerCount_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "hand_size" field.</summary>
+    public const int HandSizeFieldNumber = 10;
+    private int handSize_;
+    /// <summary>
+    /// Cards dealt to each player
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int HandSize {
+      get { return handSize_; }
+      set {
+        handSize_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "opening_card" field.</summary>
+    public const int OpeningCardFieldNumber = 11;
+    private global::TienLen.Gen.Card openingCard_;
+    /// <summary>
+    /// Set until the first play, which must include it
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::TienLen.Gen.Card OpeningCard {
+      get { return openingCard_; }
+      set {
+        openingCard_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "seat_count" field.</summary>
+    public const int SeatCountFieldNumber = 12;
+    private int seatCount_;
+    /// <summary>
+    /// Table size, 2 to 8; player_ids has one entry per seat
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int SeatCount {
+      get { return seatCount_; }
+      set {
+        seatCount_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "turn_deadline_unix_ms" field.</summary>
+    public const int TurnDeadlineUnixMsFieldNumber = 13;
+    private long turnDeadlineUnixMs_;
+    /// <summary>
+    /// Server time at which the active turn times out; 0 without a turn clock
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public long TurnDeadlineUnixMs {
+      get { return turnDeadlineUnixMs_; }
+      set {
+        turnDeadlineUnixMs_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "time_bank_ms" field.</summary>
+    public const int TimeBankMsFieldNumber = 14;
+    private static readonly pbc::MapField<string, long>.Codec _map_timeBankMs_codec
+        = new pbc::MapField<string, long>.Codec(pb::FieldCodec.ForString(10, ""), pb::FieldCodec.ForInt64(16, 0L), 114);
+    private readonly pbc::MapField<string, long> timeBankMs_ = new pbc::MapField<string, long>();
+    /// <summary>
+    /// Chess clock rooms: time left per player, in milliseconds
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::MapField<string, long> TimeBankMs {
+      get { return timeBankMs_; }
+    }
+
+    /// <summary>Field number for the "disconnected_ids" field.</summary>
+    public const int DisconnectedIdsFieldNumber = 15;
+    private static readonly pb::FieldCodec<string> _repeated_disconnectedIds_codec
+        = pb::FieldCodec.ForString(122);
+    private readonly pbc::RepeatedField<string> disconnectedIds_ = new pbc::RepeatedField<string>();
+    /// <summary>
+    /// Seated players who lost their connection; their seat and hand are held
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> DisconnectedIds {
+      get { return disconnectedIds_; }
+    }
+
+    /// <summary>Field number for the "phase" field.</summary>
+    public const int PhaseFieldNumber = 16;
+    private string phase_ = "";
+    /// <summary>
+    /// "waiting", "ready_check", "dealing", "playing", "settlement" or "intermission"
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Phase {
+      get { return phase_; }
+      set {
+        phase_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "phase_deadline_unix_ms" field.</summary>
+    public const int PhaseDeadlineUnixMsFieldNumber = 17;
+    private long phaseDeadlineUnixMs_;
+    /// <summary>
+    /// Server time at which a timed phase (dealing, settlement) ends; 0 otherwise
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public long PhaseDeadlineUnixMs {
+      get { return phaseDeadlineUnixMs_; }
+      set {
+        phaseDeadlineUnixMs_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "ready_ids" field.</summary>
+    public const int ReadyIdsFieldNumber = 18;
+    private static readonly pb::FieldCodec<string> _repeated_readyIds_codec
+        = pb::FieldCodec.ForString(146);
+    private readonly pbc::RepeatedField<string> readyIds_ = new pbc::RepeatedField<string>();
+    /// <summary>
+    /// Seated players who sent OP_READY for the next game
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> ReadyIds {
+      get { return readyIds_; }
+    }
+
+    /// <summary>Field number for the "direction" field.</summary>
+    public const int DirectionFieldNumber = 19;
+    private string direction_ = "";
+    /// <summary>
+    /// Way turns travel: "counter_clockwise" goes down the seat numbers, "clockwise" up
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Direction {
+      get { return direction_; }
+      set {
+        direction_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "config" field.</summary>
+    public const int ConfigFieldNumber = 20;
+    private global::TienLen.Gen.RoomConfig config_;
+    /// <summary>
+    /// Settings the room was created with
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::TienLen.Gen.RoomConfig Config {
+      get { return config_; }
+      set {
+        config_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as MatchStatePacket);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(MatchStatePacket other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (IsPlaying != other.IsPlaying) return false;
+      if (OwnerId != other.OwnerId) return false;
+      if(!board_.Equals(other.board_)) return false;
+      if (ActivePlayerId != other.ActivePlayerId) return false;
+      if(!playerIds_.Equals(other.playerIds_)) return false;
+      if (Variant != other.Variant) return false;
+      if(!botIds_.Equals(other.botIds_)) return false;
+      if (NextSeedHash != other.NextSeedHash) return false;
+      if (PlayerCount != other.PlayerCount) return false;
+      if (HandSize != other.HandSize) return false;
+      if (!object.Equals(OpeningCard, other.OpeningCard)) return false;
+      if (SeatCount != other.SeatCount) return false;
+      if (TurnDeadlineUnixMs != other.TurnDeadlineUnixMs) return false;
+      if (!TimeBankMs.Equals(other.TimeBankMs)) return false;
+      if(!disconnectedIds_.Equals(other.disconnectedIds_)) return false;
+      if (Phase != other.Phase) return false;
+      if (PhaseDeadlineUnixMs != other.PhaseDeadlineUnixMs) return false;
+      if(!readyIds_.Equals(other.readyIds_)) return false;
+      if (Direction != other.Direction) return false;
+      if (!object.Equals(Config, other.Config)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (IsPlaying != false) hash ^= IsPlaying.GetHashCode();
+      if (OwnerId.Length != 0) hash ^= OwnerId.GetHashCode();
+      hash ^= board_.GetHashCode();
+      if (ActivePlayerId.Length != 0) hash ^= ActivePlayerId.GetHashCode();
+      hash ^= playerIds_.GetHashCode();
+      if (Variant.Length != 0) hash ^= Variant.GetHashCode();
+      hash ^= botIds_.GetHashCode();
+      if (NextSeedHash.Length != 0) hash ^= NextSeedHash.GetHashCode();
+      if (PlayerCount != 0) hash ^= PlayerCount.GetHashCode();
+      if (HandSize != 0) hash ^= HandSize.GetHashCode();
+      if (openingCard_ != null) hash ^= OpeningCard.GetHashCode();
+      if (SeatCount != 0) hash ^= SeatCount.GetHashCode();
+      if (TurnDeadlineUnixMs != 0L) hash ^= TurnDeadlineUnixMs.GetHashCode();
+      hash ^= TimeBankMs.GetHashCode();
+      hash ^= disconnectedIds_.GetHashCode();
+      if (Phase.Length != 0) hash ^= Phase.GetHashCode();
+      if (PhaseDeadlineUnixMs != 0L) hash ^= PhaseDeadlineUnixMs.GetHashCode();
+      hash ^= readyIds_.GetHashCode();
+      if (Direction.Length != 0) hash ^= Direction.GetHashCode();
+      if (config_ != null) hash ^= Config.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (IsPlaying != false) {
+        output.WriteRawTag(8);
+        output.WriteBool(IsPlaying);
+      }
+      if (OwnerId.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(OwnerId);
+      }
+      board_.WriteTo(output, _repeated_board_codec);
+      if (ActivePlayerId.Length != 0) {
+        output.WriteRawTag(34);
+        output.WriteString(ActivePlayerId);
+      }
+      playerIds_.WriteTo(output, _repeated_playerIds_codec);
+      if (Variant.Length != 0) {
+        output.WriteRawTag(50);
+        output.WriteString(Variant);
+      }
+      botIds_.WriteTo(output, _repeated_botIds_codec);
+      if (NextSeedHash.Length != 0) {
+        output.WriteRawTag(66);
+        output.WriteString(NextSeedHash);
+      }
+      if (PlayerCount != 0) {
+        output.WriteRawTag(72);
+        output.WriteInt32(PlayerCount);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(80);
+        output.WriteInt32(HandSize);
+      }
+      if (openingCard_ != null) {
+        output.WriteRawTag(90);
+        output.WriteMessage(OpeningCard);
+      }
+      if (SeatCount != 0) {
+        output.WriteRawTag(96);
+        output.WriteInt32(SeatCount);
+      }
+      if (TurnDeadlineUnixMs != 0L) {
+        output.WriteRawTag(104);
+        output.WriteInt64(TurnDeadlineUnixMs);
+      }
+      timeBankMs_.WriteTo(output, _map_timeBankMs_codec);
+      disconnectedIds_.WriteTo(output, _repeated_disconnectedIds_codec);
+      if (Phase.Length != 0) {
+        output.WriteRawTag(130, 1);
+        output.WriteString(Phase);
+      }
+      if (PhaseDeadlineUnixMs != 0L) {
+        output.WriteRawTag(136, 1);
+        output.WriteInt64(PhaseDeadlineUnixMs);
+      }
+      readyIds_.WriteTo(output, _repeated_readyIds_codec);
+      if (Direction.Length != 0) {
+        output.WriteRawTag(154, 1);
+        output.WriteString(Direction);
+      }
+      if (config_ != null) {
+        output.WriteRawTag(162, 1);
+        output.WriteMessage(Config);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (IsPlaying != false) {
+        output.WriteRawTag(8);
+        output.WriteBool(IsPlaying);
+      }
+      if (OwnerId.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(OwnerId);
+      }
+      board_.WriteTo(ref output, _repeated_board_codec);
+      if (ActivePlayerId.Length != 0) {
+        output.WriteRawTag(34);
+        output.WriteString(ActivePlayerId);
+      }
+      playerIds_.WriteTo(ref output, _repeated_playerIds_codec);
+      if (Variant.Length != 0) {
+        output.WriteRawTag(50);
+        output.WriteString(Variant);
+      }
+      botIds_.WriteTo(ref output, _repeated_botIds_codec);
+      if (NextSeedHash.Length != 0) {
+        output.WriteRawTag(66);
+        output.WriteString(NextSeedHash);
+      }
+      if (PlayerCount != 0) {
+        output.WriteRawTag(72);
+        output.WriteInt32(PlayerCount);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(80);
+        output.WriteInt32(HandSize);
+      }
+      if (openingCard_ != null) {
+        output.WriteRawTag(90);
+        output.WriteMessage(OpeningCard);
+      }
+      if (SeatCount != 0) {
+        output.WriteRawTag(96);
+        output.WriteInt32(SeatCount);
+      }
+      if (TurnDeadlineUnixMs != 0L) {
+        output.WriteRawTag(104);
+        output.WriteInt64(TurnDeadlineUnixMs);
+      }
+      timeBankMs_.WriteTo(ref output, _map_timeBankMs_codec);
+      disconnectedIds_.WriteTo(ref output, _repeated_disconnectedIds_codec);
+      if (Phase.Length != 0) {
+        output.WriteRawTag(130, 1);
+        output.WriteString(Phase);
+      }
+      if (PhaseDeadlineUnixMs != 0L) {
+        output.WriteRawTag(136, 1);
+        output.WriteInt64(PhaseDeadlineUnixMs);
+      }
+      readyIds_.WriteTo(ref output, _repeated_readyIds_codec);
+      if (Direction.Length != 0) {
+        output.WriteRawTag(154, 1);
+        output.WriteString(Direction);
+      }
+      if (config_ != null) {
+        output.WriteRawTag(162, 1);
+        output.WriteMessage(Config);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (IsPlaying != false) {
+        size += 1 + 1;
+      }
+      if (OwnerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(OwnerId);
+      }
+      size += board_.CalculateSize(_repeated_board_codec);
+      if (ActivePlayerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(ActivePlayerId);
+      }
+      size += playerIds_.CalculateSize(_repeated_playerIds_codec);
+      if (Variant.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Variant);
+      }
+      size += botIds_.CalculateSize(_repeated_botIds_codec);
+      if (NextSeedHash.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(NextSeedHash);
+      }
+      if (PlayerCount != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(PlayerCount);
+      }
+      if (HandSize != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(HandSize);
+      }
+      if (openingCard_ != null) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(OpeningCard);
+      }
+      if (SeatCount != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(SeatCount);
+      }
+      if (TurnDeadlineUnixMs != 0L) {
+        size += 1 + pb::CodedOutputStream.ComputeInt64Size(TurnDeadlineUnixMs);
+      }
+      size += timeBankMs_.CalculateSize(_map_timeBankMs_codec);
+      size += disconnectedIds_.CalculateSize(_repeated_disconnectedIds_codec);
+      if (Phase.Length != 0) {
+        size += 2 + pb::CodedOutputStream.ComputeStringSize(Phase);
+      }
+      if (PhaseDeadlineUnixMs != 0L) {
+        size += 2 + pb::CodedOutputStream.ComputeInt64Size(PhaseDeadlineUnixMs);
+      }
+      size += readyIds_.CalculateSize(_repeated_readyIds_codec);
+      if (Direction.Length != 0) {
+        size += 2 + pb::CodedOutputStream.ComputeStringSize(Direction);
+      }
+      if (config_ != null) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(Config);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(MatchStatePacket other) {
+      if (other == null) {
+        return;
+      }
+      if (other.IsPlaying != false) {
+        IsPlaying = other.IsPlaying;
+      }
+      if (other.OwnerId.Length != 0) {
+        OwnerId = other.OwnerId;
+      }
+      board_.Add(other.board_);
+      if (other.ActivePlayerId.Length != 0) {
+        ActivePlayerId = other.ActivePlayerId;
+      }
+      playerIds_.Add(other.playerIds_);
+      if (other.Variant.Length != 0) {
+        Variant = other.Variant;
+      }
+      botIds_.Add(other.botIds_);
+      if (other.NextSeedHash.Length != 0) {
+        NextSeedHash = other.NextSeedHash;
+      }
+      if (other.PlayerCount != 0) {
+        PlayerCount = other.PlayerCount;
+      }
+      if (other.HandSize != 0) {
+        HandSize = other.HandSize;
+      }
+      if (other.openingCard_ != null) {
+        if (openingCard_ == null) {
+          OpeningCard = new global::TienLen.Gen.Card();
+        }
+        OpeningCard.MergeFrom(other.OpeningCard);
+      }
+      if (other.SeatCount != 0) {
+        SeatCount = other.SeatCount;
+      }
+      if (other.TurnDeadlineUnixMs != 0L) {
+        TurnDeadlineUnixMs = other.TurnDeadlineUnixMs;
+      }
+      timeBankMs_.MergeFrom(other.timeBankMs_);
+      disconnectedIds_.Add(other.disconnectedIds_);
+      if (other.Phase.Length != 0) {
+        Phase = other.Phase;
+      }
+      if (other.PhaseDeadlineUnixMs != 0L) {
+        PhaseDeadlineUnixMs = other.PhaseDeadlineUnixMs;
+      }
+      readyIds_.Add(other.readyIds_);
+      if (other.Direction.Length != 0) {
+        Direction = other.Direction;
+      }
+      if (other.config_ != null) {
+        if (config_ == null) {
+          Config = new global::TienLen.Gen.RoomConfig();
+        }
+        Config.MergeFrom(other.Config);
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            IsPlaying = input.ReadBool();
+            break;
+          }
+          case 18: {
+            OwnerId = input.ReadString();
+            break;
+          }
+          case 26: {
+            board_.AddEntriesFrom(input, _repeated_board_codec);
+            break;
+          }
+          case 34: {
+            ActivePlayerId = input.ReadString();
+            break;
+          }
+          case 42: {
+            playerIds_.AddEntriesFrom(input, _repeated_playerIds_codec);
+            break;
+          }
+          case 50: {
+            Variant = input.ReadString();
+            break;
+          }
+          case 58: {
+            botIds_.AddEntriesFrom(input, _repeated_botIds_codec);
+            break;
+          }
+          case 66: {
+            NextSeedHash = input.ReadString();
+            break;
+          }
+          case 72: {
+            PlayerCount = input.ReadInt32();
+            break;
+          }
+          case 80: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 90: {
+            if (openingCard_ == null) {
+              OpeningCard = new global::TienLen.Gen.Card();
+            }
+            input.ReadMessage(OpeningCard);
+            break;
+          }
+          case 96: {
+            SeatCount = input.ReadInt32();
+            break;
+          }
+          case 104: {
+            TurnDeadlineUnixMs = input.ReadInt64();
+            break;
+          }
+          case 114: {
+            timeBankMs_.AddEntriesFrom(input, _map_timeBankMs_codec);
+            break;
+          }
+          case 122: {
+            disconnectedIds_.AddEntriesFrom(input, _repeated_disconnectedIds_codec);
+            break;
+          }
+          case 130: {
+            Phase = input.ReadString();
+            break;
+          }
+          case 136: {
+            PhaseDeadlineUnixMs = input.ReadInt64();
+            break;
+          }
+          case 146: {
+            readyIds_.AddEntriesFrom(input, _repeated_readyIds_codec);
+            break;
+          }
+          case 154: {
+            Direction = input.ReadString();
+            break;
+          }
+          case 162: {
+            if (config_ == null) {
+              Config = new global::TienLen.Gen.RoomConfig();
+            }
+            input.ReadMessage(Config);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            IsPlaying = input.ReadBool();
+            break;
+          }
+          case 18: {
+            OwnerId = input.ReadString();
+            break;
+          }
+          case 26: {
+            board_.AddEntriesFrom(ref input, _repeated_board_codec);
+            break;
+          }
+          case 34: {
+            ActivePlayerId = input.ReadString();
+            break;
+          }
+          case 42: {
+            playerIds_.AddEntriesFrom(ref input, _repeated_playerIds_codec);
+            break;
+          }
+          case 50: {
+            Variant = input.ReadString();
+            break;
+          }
+          case 58: {
+            botIds_.AddEntriesFrom(ref input, _repeated_botIds_codec);
+            break;
+          }
+          case 66: {
+            NextSeedHash = input.ReadString();
+            break;
+          }
+          case 72: {
+            PlayerCount = input.ReadInt32();
+            break;
+          }
+          case 80: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 90: {
+            if (openingCard_ == null) {
+              OpeningCard = new global::TienLen.Gen.Card();
+            }
+            input.ReadMessage(OpeningCard);
+            break;
+          }
+          case 96: {
+            SeatCount = input.ReadInt32();
+            break;
+          }
+          case 104: {
+            TurnDeadlineUnixMs = input.ReadInt64();
+            break;
+          }
+          case 114: {
+            timeBankMs_.AddEntriesFrom(ref input, _map_timeBankMs_codec);
+            break;
+          }
+          case 122: {
+            disconnectedIds_.AddEntriesFrom(ref input, _repeated_disconnectedIds_codec);
+            break;
+          }
+          case 130: {
+            Phase = input.ReadString();
+            break;
+          }
+          case 136: {
+            PhaseDeadlineUnixMs = input.ReadInt64();
+            break;
+          }
+          case 146: {
+            readyIds_.AddEntriesFrom(ref input, _repeated_readyIds_codec);
+            break;
+          }
+          case 154: {
+            Direction = input.ReadString();
+            break;
+          }
+          case 162: {
+            if (config_ == null) {
+              Config = new global::TienLen.Gen.RoomConfig();
+            }
+            input.ReadMessage(Config);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Room settings chosen when the match is created; the create_match RPC payload is the
+  /// JSON form of this message, except that it gives the "password" itself.
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RoomConfig : pb::IMessage<RoomConfig>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RoomConfig> _parser = new pb::MessageParser<RoomConfig>(() => new RoomConfig());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RoomConfig> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[10]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomConfig() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomConfig(RoomConfig other) : this() {
+      variant_ = other.variant_;
+      seats_ = other.seats_;
+      handSize_ = other.handSize_;
+      dealAll_ = other.dealAll_;
+      direction_ = other.direction_;
+      turnSeconds_ = other.turnSeconds_;
+      timeBankSeconds_ = other.timeBankSeconds_;
+      incrementSeconds_ = other.incrementSeconds_;
+      disconnectGraceSeconds_ = other.disconnectGraceSeconds_;
+      stake_ = other.stake_;
+      private_ = other.private_;
+      hintsAllowed_ = other.hintsAllowed_;
+      maxSpectators_ = other.maxSpectators_;
+      autoRestart_ = other.autoRestart_;
+      hasPassword_ = other.hasPassword_;
+      joinCode_ = other.joinCode_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomConfig Clone() {
+      return new RoomConfig(this);
+    }
+
+    /// <summary>Field number for the "variant" field.</summary>
+    public const int VariantFieldNumber = 1;
+    private string variant_ = "";
+    /// <summary>
+    /// "southern" or "northern"
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Variant {
+      get { return variant_; }
+      set {
+        variant_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "seats" field.</summary>
+    public const int SeatsFieldNumber = 2;
+    private int seats_;
+    /// <summary>
+    /// 2 to 8
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int Seats {
+      get { return seats_; }
+      set {
+        seats_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "hand_size" field.</summary>
+    public const int HandSizeFieldNumber = 3;
+    private int handSize_;
+    /// <summary>
+    /// 0 means 13
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int HandSize {
+      get { return handSize_; }
+      set {
+        handSize_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "deal_all" field.</summary>
+    public const int DealAllFieldNumber = 4;
+    private bool dealAll_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool DealAll {
+      get { return dealAll_; }
+      set {
+        dealAll_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "direction" field.</summary>
+    public const int DirectionFieldNumber = 5;
+    private string direction_ = "";
+    /// <summary>
+    /// "counter_clockwise" or "clockwise"
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Direction {
+      get { return direction_; }
+      set {
+        direction_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "turn_seconds" field.</summary>
+    public const int TurnSecondsFieldNumber = 6;
+    private int turnSeconds_;
+    /// <summary>
+    /// 0 plays without a turn clock
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int TurnSeconds {
+      get { return turnSeconds_; }
+      set {
+        turnSeconds_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "time_bank_seconds" field.</summary>
+    public const int TimeBankSecondsFieldNumber = 7;
+    private int timeBankSeconds_;
+    /// <summary>
+    /// 0 plays without a time bank
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int TimeBankSeconds {
+      get { return timeBankSeconds_; }
+      set {
+        timeBankSeconds_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "increment_seconds" field.</summary>
+    public const int IncrementSecondsFieldNumber = 8;
+    private int incrementSeconds_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int IncrementSeconds {
+      get { return incrementSeconds_; }
+      set {
+        incrementSeconds_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "disconnect_grace_seconds" field.</summary>
+    public const int DisconnectGraceSecondsFieldNumber = 9;
+    private int disconnectGraceSeconds_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int DisconnectGraceSeconds {
+      get { return disconnectGraceSeconds_; }
+      set {
+        disconnectGraceSeconds_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "stake" field.</summary>
+    public const int StakeFieldNumber = 10;
+    private long stake_;
+    /// <summary>
+    /// Chips per settlement point; 0 plays for fun
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public long Stake {
+      get { return stake_; }
+      set {
+        stake_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "private" field.</summary>
+    public const int PrivateFieldNumber = 11;
+    private bool private_;
+    /// <summary>
+    /// Kept out of quick match
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Private {
+      get { return private_; }
+      set {
+        private_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "hints_allowed" field.</summary>
+    public const int HintsAllowedFieldNumber = 12;
+    private bool hintsAllowed_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HintsAllowed {
+      get { return hintsAllowed_; }
+      set {
+        hintsAllowed_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "max_spectators" field.</summary>
+    public const int MaxSpectatorsFieldNumber = 13;
+    private int maxSpectators_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int MaxSpectators {
+      get { return maxSpectators_; }
+      set {
+        maxSpectators_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "auto_restart" field.</summary>
+    public const int AutoRestartFieldNumber = 14;
+    private bool autoRestart_;
+    /// <summary>
+    /// Deal every game after the first without a ready check
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool AutoRestart {
+      get { return autoRestart_; }
+      set {
+        autoRestart_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "has_password" field.</summary>
+    public const int HasPasswordFieldNumber = 15;
+    private bool hasPassword_;
+    /// <summary>
+    /// Joining needs the "password" join metadata
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasPassword {
+      get { return hasPassword_; }
+      set {
+        hasPassword_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "join_code" field.</summary>
+    public const int JoinCodeFieldNumber = 16;
+    private string joinCode_ = "";
+    /// <summary>
+    /// Private rooms: the code to pass to join_by_code
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string JoinCode {
+      get { return joinCode_; }
+      set {
+        joinCode_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RoomConfig);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RoomConfig other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Variant != other.Variant) return false;
+      if (Seats != other.Seats) return false;
+      if (HandSize != other.HandSize) return false;
+      if (DealAll != other.DealAll) return false;
+      if (Direction != other.Direction) return false;
+      if (TurnSeconds != other.TurnSeconds) return false;
+      if (TimeBankSeconds != other.TimeBankSeconds) return false;
+      if (IncrementSeconds != other.IncrementSeconds) return false;
+      if (DisconnectGraceSeconds != other.DisconnectGraceSeconds) return false;
+      if (Stake != other.Stake) return false;
+      if (Private != other.Private) return false;
+      if (HintsAllowed != other.HintsAllowed) return false;
+      if (MaxSpectators != other.MaxSpectators) return false;
+      if (AutoRestart != other.AutoRestart) return false;
+      if (HasPassword != other.HasPassword) return false;
+      if (JoinCode != other.JoinCode) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Variant.Length != 0) hash ^= Variant.GetHashCode();
+      if (Seats != 0) hash ^= Seats.GetHashCode();
+      if (HandSize != 0) hash ^= HandSize.GetHashCode();
+      if (DealAll != false) hash ^= DealAll.GetHashCode();
+      if (Direction.Length != 0) hash ^= Direction.GetHashCode();
+      if (TurnSeconds != 0) hash ^= TurnSeconds.GetHashCode();
+      if (TimeBankSeconds != 0) hash ^= TimeBankSeconds.GetHashCode();
+      if (IncrementSeconds != 0) hash ^= IncrementSeconds.GetHashCode();
+      if (DisconnectGraceSeconds != 0) hash ^= DisconnectGraceSeconds.GetHashCode();
+      if (Stake != 0L) hash ^= Stake.GetHashCode();
+      if (Private != false) hash ^= Private.GetHashCode();
+      if (HintsAllowed != false) hash ^= HintsAllowed.GetHashCode();
+      if (MaxSpectators != 0) hash ^= MaxSpectators.GetHashCode();
+      if (AutoRestart != false) hash ^= AutoRestart.GetHashCode();
+      if (HasPassword != false) hash ^= HasPassword.GetHashCode();
+      if (JoinCode.Length != 0) hash ^= JoinCode.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Variant.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Variant);
+      }
+      if (Seats != 0) {
+        output.WriteRawTag(16);
+        output.WriteInt32(Seats);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(24);
+        output.WriteInt32(HandSize);
+      }
+      if (DealAll != false) {
+        output.WriteRawTag(32);
+        output.WriteBool(DealAll);
+      }
+      if (Direction.Length != 0) {
+        output.WriteRawTag(42);
+        output.WriteString(Direction);
+      }
+      if (TurnSeconds != 0) {
+        output.WriteRawTag(48);
+        output.WriteInt32(TurnSeconds);
+      }
+      if (TimeBankSeconds != 0) {
+        output.WriteRawTag(56);
+        output.WriteInt32(TimeBankSeconds);
+      }
+      if (IncrementSeconds != 0) {
+        output.WriteRawTag(64);
+        output.WriteInt32(IncrementSeconds);
+      }
+      if (DisconnectGraceSeconds != 0) {
+        output.WriteRawTag(72);
+        output.WriteInt32(DisconnectGraceSeconds);
+      }
+      if (Stake != 0L) {
+        output.WriteRawTag(80);
+        output.WriteInt64(Stake);
+      }
+      if (Private != false) {
+        output.WriteRawTag(88);
+        output.WriteBool(Private);
+      }
+      if (HintsAllowed != false) {
+        output.WriteRawTag(96);
+        output.WriteBool(HintsAllowed);
+      }
+      if (MaxSpectators != 0) {
+        output.WriteRawTag(104);
+        output.WriteInt32(MaxSpectators);
+      }
+      if (AutoRestart != false) {
+        output.WriteRawTag(112);
+        output.WriteBool(AutoRestart);
+      }
+      if (HasPassword != false) {
+        output.WriteRawTag(120);
+        output.WriteBool(HasPassword);
+      }
+      if (JoinCode.Length != 0) {
+        output.WriteRawTag(130, 1);
+        output.WriteString(JoinCode);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Variant.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Variant);
+      }
+      if (Seats != 0) {
+        output.WriteRawTag(16);
+        output.WriteInt32(Seats);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(24);
+        output.WriteInt32(HandSize);
+      }
+      if (DealAll != false) {
+        output.WriteRawTag(32);
+        output.WriteBool(DealAll);
+      }
+      if (Direction.Length != 0) {
+        output.WriteRawTag(42);
+        output.WriteString(Direction);
+      }
+      if (TurnSeconds != 0) {
+        output.WriteRawTag(48);
+        output.WriteInt32(TurnSeconds);
+      }
+      if (TimeBankSeconds != 0) {
+        output.WriteRawTag(56);
+        output.WriteInt32(TimeBankSeconds);
+      }
+      if (IncrementSeconds != 0) {
+        output.WriteRawTag(64);
+        output.WriteInt32(IncrementSeconds);
+      }
+      if (DisconnectGraceSeconds != 0) {
+        output.WriteRawTag(72);
+        output.WriteInt32(DisconnectGraceSeconds);
+      }
+      if (Stake != 0L) {
+        output.WriteRawTag(80);
+        output.WriteInt64(Stake);
+      }
+      if (Private != false) {
+        output.WriteRawTag(88);
+        output.WriteBool(Private);
+      }
+      if (HintsAllowed != false) {
+        output.WriteRawTag(96);
+        output.WriteBool(HintsAllowed);
+      }
+      if (MaxSpectators != 0) {
+        output.WriteRawTag(104);
+        output.WriteInt32(MaxSpectators);
+      }
+      if (AutoRestart != false) {
+        output.WriteRawTag(112);
+        output.WriteBool(AutoRestart);
+      }
+      if (HasPassword != false) {
+        output.WriteRawTag(120);
+        output.WriteBool(HasPassword);
+      }
+      if (JoinCode.Length != 0) {
+        output.WriteRawTag(130, 1);
+        output.WriteString(JoinCode);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Variant.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Variant);
+      }
+      if (Seats != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(Seats);
+      }
+      if (HandSize != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(HandSize);
+      }
+      if (DealAll != false) {
+        size += 1 + 1;
+      }
+      if (Direction.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Direction);
+      }
+      if (TurnSeconds != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(TurnSeconds);
+      }
+      if (TimeBankSeconds != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(TimeBankSeconds);
+      }
+      if (IncrementSeconds != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(IncrementSeconds);
+      }
+      if (DisconnectGraceSeconds != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(DisconnectGraceSeconds);
+      }
+      if (Stake != 0L) {
+        size += 1 + pb::CodedOutputStream.ComputeInt64Size(Stake);
+      }
+      if (Private != false) {
+        size += 1 + 1;
+      }
+      if (HintsAllowed != false) {
+        size += 1 + 1;
+      }
+      if (MaxSpectators != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(MaxSpectators);
+      }
+      if (AutoRestart != false) {
+        size += 1 + 1;
+      }
+      if (HasPassword != false) {
+        size += 1 + 1;
+      }
+      if (JoinCode.Length != 0) {
+        size += 2 + pb::CodedOutputStream.ComputeStringSize(JoinCode);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RoomConfig other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Variant.Length != 0) {
+        Variant = other.Variant;
+      }
+      if (other.Seats != 0) {
+        Seats = other.Seats;
+      }
+      if (other.HandSize != 0) {
+        HandSize = other.HandSize;
+      }
+      if (other.DealAll != false) {
+        DealAll = other.DealAll;
+      }
+      if (other.Direction.Length != 0) {
+        Direction = other.Direction;
+      }
+      if (other.TurnSeconds != 0) {
+        TurnSeconds = other.TurnSeconds;
+      }
+      if (other.TimeBankSeconds != 0) {
+        TimeBankSeconds = other.TimeBankSeconds;
+      }
+      if (other.IncrementSeconds != 0) {
+        IncrementSeconds = other.IncrementSeconds;
+      }
+      if (other.DisconnectGraceSeconds != 0) {
+        DisconnectGraceSeconds = other.DisconnectGraceSeconds;
+      }
+      if (other.Stake != 0L) {
+        Stake = other.Stake;
+      }
+      if (other.Private != false) {
+        Private = other.Private;
+      }
+      if (other.HintsAllowed != false) {
+        HintsAllowed = other.HintsAllowed;
+      }
+      if (other.MaxSpectators != 0) {
+        MaxSpectators = other.MaxSpectators;
+      }
+      if (other.AutoRestart != false) {
+        AutoRestart = other.AutoRestart;
+      }
+      if (other.HasPassword != false) {
+        HasPassword = other.HasPassword;
+      }
+      if (other.JoinCode.Length != 0) {
+        JoinCode = other.JoinCode;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            Variant = input.ReadString();
+            break;
+          }
+          case 16: {
+            Seats = input.ReadInt32();
+            break;
+          }
+          case 24: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 32: {
+            DealAll = input.ReadBool();
+            break;
+          }
+          case 42: {
+            Direction = input.ReadString();
+            break;
+          }
+          case 48: {
+            TurnSeconds = input.ReadInt32();
+            break;
+          }
+          case 56: {
+            TimeBankSeconds = input.ReadInt32();
+            break;
+          }
+          case 64: {
+            IncrementSeconds = input.ReadInt32();
+            break;
+          }
+          case 72: {
+            DisconnectGraceSeconds = input.ReadInt32();
+            break;
+          }
+          case 80: {
+            Stake = input.ReadInt64();
+            break;
+          }
+          case 88: {
+            Private = input.ReadBool();
+            break;
+          }
+          case 96: {
+            HintsAllowed = input.ReadBool();
+            break;
+          }
+          case 104: {
+            MaxSpectators = input.ReadInt32();
+            break;
+          }
+          case 112: {
+            AutoRestart = input.ReadBool();
+            break;
+          }
+          case 120: {
+            HasPassword = input.ReadBool();
+            break;
+          }
+          case 130: {
+            JoinCode = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            Variant = input.ReadString();
+            break;
+          }
+          case 16: {
+            Seats = input.ReadInt32();
+            break;
+          }
+          case 24: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 32: {
+            DealAll = input.ReadBool();
+            break;
+          }
+          case 42: {
+            Direction = input.ReadString();
+            break;
+          }
+          case 48: {
+            TurnSeconds = input.ReadInt32();
+            break;
+          }
+          case 56: {
+            TimeBankSeconds = input.ReadInt32();
+            break;
+          }
+          case 64: {
+            IncrementSeconds = input.ReadInt32();
+            break;
+          }
+          case 72: {
+            DisconnectGraceSeconds = input.ReadInt32();
+            break;
+          }
+          case 80: {
+            Stake = input.ReadInt64();
+            break;
+          }
+          case 88: {
+            Private = input.ReadBool();
+            break;
+          }
+          case 96: {
+            HintsAllowed = input.ReadBool();
+            break;
+          }
+          case 104: {
+            MaxSpectators = input.ReadInt32();
+            break;
+          }
+          case 112: {
+            AutoRestart = input.ReadBool();
+            break;
+          }
+          case 120: {
+            HasPassword = input.ReadBool();
+            break;
+          }
+          case 130: {
+            JoinCode = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class ReadyRequest : pb::IMessage<ReadyRequest>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<ReadyRequest> _parser = new pb::MessageParser<ReadyRequest>(() => new ReadyRequest());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<ReadyRequest> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[11]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ReadyRequest() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ReadyRequest(ReadyRequest other) : this() {
+      entropy_ = other.entropy_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ReadyRequest Clone() {
+      return new ReadyRequest(this);
+    }
+
+    /// <summary>Field number for the "entropy" field.</summary>
+    public const int EntropyFieldNumber = 1;
+    private string entropy_ = "";
+    /// <summary>
+    /// Optional: mixed into the next deal, whose seed hash is already published
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Entropy {
+      get { return entropy_; }
+      set {
+        entropy_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as ReadyRequest);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(ReadyRequest other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Entropy != other.Entropy) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Entropy.Length != 0) hash ^= Entropy.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Entropy.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Entropy);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Entropy.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Entropy);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Entropy.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Entropy);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(ReadyRequest other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Entropy.Length != 0) {
+        Entropy = other.Entropy;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            Entropy = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            Entropy = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class AddBotRequest : pb::IMessage<AddBotRequest>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<AddBotRequest> _parser = new pb::MessageParser<AddBotRequest>(() => new AddBotRequest());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<AddBotRequest> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[12]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public AddBotRequest() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public AddBotRequest(AddBotRequest other) : this() {
+      strategy_ = other.strategy_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public AddBotRequest Clone() {
+      return new AddBotRequest(this);
+    }
+
+    /// <summary>Field number for the "strategy" field.</summary>
+    public const int StrategyFieldNumber = 1;
+    private string strategy_ = "";
+    /// <summary>
+    /// "greedy" (default) or "random"
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Strategy {
+      get { return strategy_; }
+      set {
+        strategy_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as AddBotRequest);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(AddBotRequest other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Strategy != other.Strategy) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Strategy.Length != 0) hash ^= Strategy.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Strategy.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Strategy);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Strategy.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Strategy);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Strategy.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Strategy);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(AddBotRequest other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Strategy.Length != 0) {
+        Strategy = other.Strategy;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            Strategy = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            Strategy = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RemoveBotRequest : pb::IMessage<RemoveBotRequest>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RemoveBotRequest> _parser = new pb::MessageParser<RemoveBotRequest>(() => new RemoveBotRequest());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RemoveBotRequest> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[13]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RemoveBotRequest() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RemoveBotRequest(RemoveBotRequest other) : this() {
+      botId_ = other.botId_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RemoveBotRequest Clone() {
+      return new RemoveBotRequest(this);
+    }
+
+    /// <summary>Field number for the "bot_id" field.</summary>
+    public const int BotIdFieldNumber = 1;
+    private string botId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string BotId {
+      get { return botId_; }
+      set {
+        botId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RemoveBotRequest);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RemoveBotRequest other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (BotId != other.BotId) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (BotId.Length != 0) hash ^= BotId.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (BotId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(BotId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (BotId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(BotId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (BotId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(BotId);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RemoveBotRequest other) {
+      if (other == null) {
+        return;
+      }
+      if (other.BotId.Length != 0) {
+        BotId = other.BotId;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            BotId = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            BotId = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class PlayCardRequest : pb::IMessage<PlayCardRequest>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<PlayCardRequest> _parser = new pb::MessageParser<PlayCardRequest>(() => new PlayCardRequest());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<PlayCardRequest> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[14]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PlayCardRequest() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PlayCardRequest(PlayCardRequest other) : this() {
+      cardIndices_ = other.cardIndices_.Clone();
+      cards_ = other.cards_.Clone();
+      handVersion_ = other.handVersion_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PlayCardRequest Clone() {
+      return new PlayCardRequest(this);
+    }
+
+    /// <summary>Field number for the "card_indices" field.</summary>
+    public const int CardIndicesFieldNumber = 1;
+    private static readonly pb::FieldCodec<int> _repeated_cardIndices_codec
+        = pb::FieldCodec.ForInt32(10);
+    private readonly pbc::RepeatedField<int> cardIndices_ = new pbc::RepeatedField<int>();
+    /// <summary>
+    /// Indices of cards in hand to play (legacy; prefer cards)
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<int> CardIndices {
+      get { return cardIndices_; }
+    }
+
+    /// <summary>Field number for the "cards" field.</summary>
+    public const int CardsFieldNumber = 2;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_cards_codec
+        = pb::FieldCodec.ForMessage(18, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> cards_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>
+    /// Cards to play by value; takes precedence over card_indices
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Cards {
+      get { return cards_; }
+    }
+
+    /// <summary>Field number for the "hand_version" field.</summary>
+    public const int HandVersionFieldNumber = 3;
+    private int handVersion_;
+    /// <summary>
+    /// Optional: hand version the indices refer to; stale versions are rejected
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int HandVersion {
+      get { return handVersion_; }
+      set {
+        handVersion_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as PlayCardRequest);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(PlayCardRequest other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if(!cardIndices_.Equals(other.cardIndices_)) return false;
+      if(!cards_.Equals(other.cards_)) return false;
+      if (HandVersion != other.HandVersion) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      hash ^= cardIndices_.GetHashCode();
+      hash ^= cards_.GetHashCode();
+      if (HandVersion != 0) hash ^= HandVersion.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      cardIndices_.WriteTo(output, _repeated_cardIndices_codec);
+      cards_.WriteTo(output, _repeated_cards_codec);
+      if (HandVersion != 0) {
+        output.WriteRawTag(24);
+        output.WriteInt32(HandVersion);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      cardIndices_.WriteTo(ref output, _repeated_cardIndices_codec);
+      cards_.WriteTo(ref output, _repeated_cards_codec);
+      if (HandVersion != 0) {
+        output.WriteRawTag(24);
+        output.WriteInt32(HandVersion);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      size += cardIndices_.CalculateSize(_repeated_cardIndices_codec);
+      size += cards_.CalculateSize(_repeated_cards_codec);
+      if (HandVersion != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(HandVersion);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(PlayCardRequest other) {
+      if (other == null) {
+        return;
+      }
+      cardIndices_.Add(other.cardIndices_);
+      cards_.Add(other.cards_);
+      if (other.HandVersion != 0) {
+        HandVersion = other.HandVersion;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10:
+          case 8: {
+            cardIndices_.AddEntriesFrom(input, _repeated_cardIndices_codec);
+            break;
+          }
+          case 18: {
+            cards_.AddEntriesFrom(input, _repeated_cards_codec);
+            break;
+          }
+          case 24: {
+            HandVersion = input.ReadInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10:
+          case 8: {
+            cardIndices_.AddEntriesFrom(ref input, _repeated_cardIndices_codec);
+            break;
+          }
+          case 18: {
+            cards_.AddEntriesFrom(ref input, _repeated_cards_codec);
+            break;
+          }
+          case 24: {
+            HandVersion = input.ReadInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class HintMove : pb::IMessage<HintMove>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<HintMove> _parser = new pb::MessageParser<HintMove>(() => new HintMove());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<HintMove> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[15]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HintMove() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HintMove(HintMove other) : this() {
+      cardIndices_ = other.cardIndices_.Clone();
+      cards_ = other.cards_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HintMove Clone() {
+      return new HintMove(this);
+    }
+
+    /// <summary>Field number for the "card_indices" field.</summary>
+    public const int CardIndicesFieldNumber = 1;
+    private static readonly pb::FieldCodec<int> _repeated_cardIndices_codec
+        = pb::FieldCodec.ForInt32(10);
+    private readonly pbc::RepeatedField<int> cardIndices_ = new pbc::RepeatedField<int>();
+    /// <summary>
+    /// Indices into the player's current hand
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<int> CardIndices {
+      get { return cardIndices_; }
+    }
+
+    /// <summary>Field number for the "cards" field.</summary>
+    public const int CardsFieldNumber = 2;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_cards_codec
+        = pb::FieldCodec.ForMessage(18, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> cards_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Cards {
+      get { return cards_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as HintMove);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(HintMove other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if(!cardIndices_.Equals(other.cardIndices_)) return false;
+      if(!cards_.Equals(other.cards_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      hash ^= cardIndices_.GetHashCode();
+      hash ^= cards_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      cardIndices_.WriteTo(output, _repeated_cardIndices_codec);
+      cards_.WriteTo(output, _repeated_cards_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      cardIndices_.WriteTo(ref output, _repeated_cardIndices_codec);
+      cards_.WriteTo(ref output, _repeated_cards_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      size += cardIndices_.CalculateSize(_repeated_cardIndices_codec);
+      size += cards_.CalculateSize(_repeated_cards_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(HintMove other) {
+      if (other == null) {
+        return;
+      }
+      cardIndices_.Add(other.cardIndices_);
+      cards_.Add(other.cards_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10:
+          case 8: {
+            cardIndices_.AddEntriesFrom(input, _repeated_cardIndices_codec);
+            break;
+          }
+          case 18: {
+            cards_.AddEntriesFrom(input, _repeated_cards_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10:
+          case 8: {
+            cardIndices_.AddEntriesFrom(ref input, _repeated_cardIndices_codec);
+            break;
+          }
+          case 18: {
+            cards_.AddEntriesFrom(ref input, _repeated_cards_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class HintPacket : pb::IMessage<HintPacket>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<HintPacket> _parser = new pb::MessageParser<HintPacket>(() => new HintPacket());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<HintPacket> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[16]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HintPacket() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HintPacket(HintPacket other) : this() {
+      moves_ = other.moves_.Clone();
+      canPass_ = other.canPass_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HintPacket Clone() {
+      return new HintPacket(this);
+    }
+
+    /// <summary>Field number for the "moves" field.</summary>
+    public const int MovesFieldNumber = 1;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.HintMove> _repeated_moves_codec
+        = pb::FieldCodec.ForMessage(10, global::TienLen.Gen.HintMove.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.HintMove> moves_ = new pbc::RepeatedField<global::TienLen.Gen.HintMove>();
+    /// <summary>
+    /// Suggested plays, best first
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.HintMove> Moves {
+      get { return moves_; }
+    }
+
+    /// <summary>Field number for the "can_pass" field.</summary>
+    public const int CanPassFieldNumber = 2;
+    private bool canPass_;
+    /// <summary>
+    /// Passing is also allowed
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool CanPass {
+      get { return canPass_; }
+      set {
+        canPass_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as HintPacket);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(HintPacket other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if(!moves_.Equals(other.moves_)) return false;
+      if (CanPass != other.CanPass) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      hash ^= moves_.GetHashCode();
+      if (CanPass != false) hash ^= CanPass.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      moves_.WriteTo(output, _repeated_moves_codec);
+      if (CanPass != false) {
+        output.WriteRawTag(16);
+        output.WriteBool(CanPass);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      moves_.WriteTo(ref output, _repeated_moves_codec);
+      if (CanPass != false) {
+        output.WriteRawTag(16);
+        output.WriteBool(CanPass);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      size += moves_.CalculateSize(_repeated_moves_codec);
+      if (CanPass != false) {
+        size += 1 + 1;
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(HintPacket other) {
+      if (other == null) {
+        return;
+      }
+      moves_.Add(other.moves_);
+      if (other.CanPass != false) {
+        CanPass = other.CanPass;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            moves_.AddEntriesFrom(input, _repeated_moves_codec);
+            break;
+          }
+          case 16: {
+            CanPass = input.ReadBool();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            moves_.AddEntriesFrom(ref input, _repeated_moves_codec);
+            break;
+          }
+          case 16: {
+            CanPass = input.ReadBool();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class TurnUpdatePacket : pb::IMessage<TurnUpdatePacket>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<TurnUpdatePacket> _parser = new pb::MessageParser<TurnUpdatePacket>(() => new TurnUpdatePacket());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<TurnUpdatePacket> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[17]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TurnUpdatePacket() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TurnUpdatePacket(TurnUpdatePacket other) : this() {
+      activePlayerId_ = other.activePlayerId_;
+      lastPlayedCards_ = other.lastPlayedCards_.Clone();
+      secondsRemaining_ = other.secondsRemaining_;
+      deadlineUnixMs_ = other.deadlineUnixMs_;
+      timeBankMs_ = other.timeBankMs_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TurnUpdatePacket Clone() {
+      return new TurnUpdatePacket(this);
+    }
+
+    /// <summary>Field number for the "active_player_id" field.</summary>
+    public const int ActivePlayerIdFieldNumber = 1;
+    private string activePlayerId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string ActivePlayerId {
+      get { return activePlayerId_; }
+      set {
+        activePlayerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "last_played_cards" field.</summary>
+    public const int LastPlayedCardsFieldNumber = 2;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_lastPlayedCards_codec
+        = pb::FieldCodec.ForMessage(18, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> lastPlayedCards_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>
+    /// Cards currently on table
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> LastPlayedCards {
+      get { return lastPlayedCards_; }
+    }
+
+    /// <summary>Field number for the "seconds_remaining" field.</summary>
+    public const int SecondsRemainingFieldNumber = 3;
+    private int secondsRemaining_;
+    /// <summary>
+    /// Time left on the turn clock; 0 when the room has none
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int SecondsRemaining {
+      get { return secondsRemaining_; }
+      set {
+        secondsRemaining_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "deadline_unix_ms" field.</summary>
+    public const int DeadlineUnixMsFieldNumber = 4;
+    private long deadlineUnixMs_;
+    /// <summary>
+    /// Server time at which the turn times out; 0 when the room has none
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public long DeadlineUnixMs {
+      get { return deadlineUnixMs_; }
+      set {
+        deadlineUnixMs_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "time_bank_ms" field.</summary>
+    public const int TimeBankMsFieldNumber = 5;
+    private static readonly pbc::MapField<string, long>.Codec _map_timeBankMs_codec
+        = new pbc::MapField<string, long>.Codec(pb::FieldCodec.ForString(10, ""), pb::FieldCodec.ForInt64(16, 0L), 42);
+    private readonly pbc::MapField<string, long> timeBankMs_ = new pbc::MapField<string, long>();
+    /// <summary>
+    /// Chess clock rooms: time left per player, in milliseconds
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::MapField<string, long> TimeBankMs {
+      get { return timeBankMs_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as TurnUpdatePacket);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(TurnUpdatePacket other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (ActivePlayerId != other.ActivePlayerId) return false;
+      if(!lastPlayedCards_.Equals(other.lastPlayedCards_)) return false;
+      if (SecondsRemaining != other.SecondsRemaining) return false;
+      if (DeadlineUnixMs != other.DeadlineUnixMs) return false;
+      if (!TimeBankMs.Equals(other.TimeBankMs)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (ActivePlayerId.Length != 0) hash ^= ActivePlayerId.GetHashCode();
+      hash ^= lastPlayedCards_.GetHashCode();
+      if (SecondsRemaining != 0) hash ^= SecondsRemaining.GetHashCode();
+      if (DeadlineUnixMs != 0L) hash ^= DeadlineUnixMs.GetHashCode();
+      hash ^= TimeBankMs.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (ActivePlayerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(ActivePlayerId);
+      }
+      lastPlayedCards_.WriteTo(output, _repeated_lastPlayedCards_codec);
+      if (SecondsRemaining != 0) {
+        output.WriteRawTag(24);
+        output.WriteInt32(SecondsRemaining);
+      }
+      if (DeadlineUnixMs != 0L) {
+        output.WriteRawTag(32);
+        output.WriteInt64(DeadlineUnixMs);
+      }
+      timeBankMs_.WriteTo(output, _map_timeBankMs_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (ActivePlayerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(ActivePlayerId);
+      }
+      lastPlayedCards_.WriteTo(ref output, _repeated_lastPlayedCards_codec);
+      if (SecondsRemaining != 0) {
+        output.WriteRawTag(24);
+        output.WriteInt32(SecondsRemaining);
+      }
+      if (DeadlineUnixMs != 0L) {
+        output.WriteRawTag(32);
+        output.WriteInt64(DeadlineUnixMs);
+      }
+      timeBankMs_.WriteTo(ref output, _map_timeBankMs_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (ActivePlayerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(ActivePlayerId);
+      }
+      size += lastPlayedCards_.CalculateSize(_repeated_lastPlayedCards_codec);
+      if (SecondsRemaining != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(SecondsRemaining);
+      }
+      if (DeadlineUnixMs != 0L) {
+        size += 1 + pb::CodedOutputStream.ComputeInt64Size(DeadlineUnixMs);
+      }
+      size += timeBankMs_.CalculateSize(_map_timeBankMs_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(TurnUpdatePacket other) {
+      if (other == null) {
+        return;
+      }
+      if (other.ActivePlayerId.Length != 0) {
+        ActivePlayerId = other.ActivePlayerId;
+      }
+      lastPlayedCards_.Add(other.lastPlayedCards_);
+      if (other.SecondsRemaining != 0) {
+        SecondsRemaining = other.SecondsRemaining;
+      }
+      if (other.DeadlineUnixMs != 0L) {
+        DeadlineUnixMs = other.DeadlineUnixMs;
+      }
+      timeBankMs_.MergeFrom(other.timeBankMs_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            ActivePlayerId = input.ReadString();
+            break;
+          }
+          case 18: {
+            lastPlayedCards_.AddEntriesFrom(input, _repeated_lastPlayedCards_codec);
+            break;
+          }
+          case 24: {
+            SecondsRemaining = input.ReadInt32();
+            break;
+          }
+          case 32: {
+            DeadlineUnixMs = input.ReadInt64();
+            break;
+          }
+          case 42: {
+            timeBankMs_.AddEntriesFrom(input, _map_timeBankMs_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            ActivePlayerId = input.ReadString();
+            break;
+          }
+          case 18: {
+            lastPlayedCards_.AddEntriesFrom(ref input, _repeated_lastPlayedCards_codec);
+            break;
+          }
+          case 24: {
+            SecondsRemaining = input.ReadInt32();
+            break;
+          }
+          case 32: {
+            DeadlineUnixMs = input.ReadInt64();
+            break;
+          }
+          case 42: {
+            timeBankMs_.AddEntriesFrom(ref input, _map_timeBankMs_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class TurnTimedOutPacket : pb::IMessage<TurnTimedOutPacket>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<TurnTimedOutPacket> _parser = new pb::MessageParser<TurnTimedOutPacket>(() => new TurnTimedOutPacket());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<TurnTimedOutPacket> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[18]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TurnTimedOutPacket() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TurnTimedOutPacket(TurnTimedOutPacket other) : this() {
+      playerId_ = other.playerId_;
+      cards_ = other.cards_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TurnTimedOutPacket Clone() {
+      return new TurnTimedOutPacket(this);
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private string playerId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "cards" field.</summary>
+    public const int CardsFieldNumber = 2;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_cards_codec
+        = pb::FieldCodec.ForMessage(18, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> cards_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>
+    /// The lowest single, played when the player led the round; empty for a pass
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Cards {
+      get { return cards_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as TurnTimedOutPacket);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(TurnTimedOutPacket other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (PlayerId != other.PlayerId) return false;
+      if(!cards_.Equals(other.cards_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (PlayerId.Length != 0) hash ^= PlayerId.GetHashCode();
+      hash ^= cards_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (PlayerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(PlayerId);
+      }
+      cards_.WriteTo(output, _repeated_cards_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (PlayerId.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(PlayerId);
+      }
+      cards_.WriteTo(ref output, _repeated_cards_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (PlayerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(PlayerId);
+      }
+      size += cards_.CalculateSize(_repeated_cards_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(TurnTimedOutPacket other) {
+      if (other == null) {
+        return;
+      }
+      if (other.PlayerId.Length != 0) {
+        PlayerId = other.PlayerId;
+      }
+      cards_.Add(other.cards_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            PlayerId = input.ReadString();
+            break;
+          }
+          case 18: {
+            cards_.AddEntriesFrom(input, _repeated_cards_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            PlayerId = input.ReadString();
+            break;
+          }
+          case 18: {
+            cards_.AddEntriesFrom(ref input, _repeated_cards_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Complete engine state, for persistence and moving a table between nodes.
+  /// It holds every hand and the secret deal seed, so it is never sent to players.
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class GameState : pb::IMessage<GameState>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<GameState> _parser = new pb::MessageParser<GameState>(() => new GameState());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<GameState> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[19]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public GameState() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public GameState(GameState other) : this() {
+      version_ = other.version_;
+      variant_ = other.variant_;
+      seed_ = other.seed_;
+      isPlaying_ = other.isPlaying_;
+      ownerId_ = other.ownerId_;
+      players_ = other.players_.Clone();
+      turnOrder_ = other.turnOrder_.Clone();
+      currentIdx_ = other.currentIdx_;
+      hands_ = other.hands_.Clone();
+      handVersions_ = other.handVersions_.Clone();
+      deck_ = other.deck_.Clone();
+      commitment_ = other.commitment_ != null ? other.commitment_.Clone() : null;
+      board_ = other.board_.Clone();
+      lastActor_ = other.lastActor_;
+      roundSkippers_ = other.roundSkippers_.Clone();
+      chopChainOpen_ = other.chopChainOpen_;
+      winners_ = other.winners_.Clone();
+      finishedPlayers_ = other.finishedPlayers_.Clone();
+      chops_ = other.chops_.Clone();
+      cardsPlayed_ = other.cardsPlayed_.Clone();
+      endedByInstantWin_ = other.endedByInstantWin_;
+      log_ = other.log_.Clone();
+      deal_ = other.deal_ != null ? other.deal_.Clone() : null;
+      handSize_ = other.handSize_;
+      openingCard_ = other.openingCard_ != null ? other.openingCard_.Clone() : null;
+      abandoned_ = other.abandoned_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public GameState Clone() {
+      return new GameState(this);
+    }
+
+    /// <summary>Field number for the "version" field.</summary>
+    public const int VersionFieldNumber = 1;
+    private int version_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int Version {
+      get { return version_; }
+      set {
+        version_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "variant" field.</summary>
+    public const int VariantFieldNumber = 2;
+    private string variant_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Variant {
+      get { return variant_; }
+      set {
+        variant_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "seed" field.</summary>
+    public const int SeedFieldNumber = 3;
+    private long seed_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public long Seed {
+      get { return seed_; }
+      set {
+        seed_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "is_playing" field.</summary>
+    public const int IsPlayingFieldNumber = 4;
+    private bool isPlaying_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool IsPlaying {
+      get { return isPlaying_; }
+      set {
+        isPlaying_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "owner_id" field.</summary>
+    public const int OwnerIdFieldNumber = 5;
+    private string ownerId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string OwnerId {
+      get { return ownerId_; }
+      set {
+        ownerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "players" field.</summary>
+    public const int PlayersFieldNumber = 6;
+    private static readonly pb::FieldCodec<string> _repeated_players_codec
+        = pb::FieldCodec.ForString(50);
+    private readonly pbc::RepeatedField<string> players_ = new pbc::RepeatedField<string>();
+    /// <summary>
+    /// In the order given to Start
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> Players {
+      get { return players_; }
+    }
+
+    /// <summary>Field number for the "turn_order" field.</summary>
+    public const int TurnOrderFieldNumber = 7;
+    private static readonly pb::FieldCodec<string> _repeated_turnOrder_codec
+        = pb::FieldCodec.ForString(58);
+    private readonly pbc::RepeatedField<string> turnOrder_ = new pbc::RepeatedField<string>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> TurnOrder {
+      get { return turnOrder_; }
+    }
+
+    /// <summary>Field number for the "current_idx" field.</summary>
+    public const int CurrentIdxFieldNumber = 8;
+    private int currentIdx_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CurrentIdx {
+      get { return currentIdx_; }
+      set {
+        currentIdx_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "hands" field.</summary>
+    public const int HandsFieldNumber = 9;
+    private static readonly pbc::MapField<string, global::TienLen.Gen.CardList>.Codec _map_hands_codec
+        = new pbc::MapField<string, global::TienLen.Gen.CardList>.Codec(pb::FieldCodec.ForString(10, ""), pb::FieldCodec.ForMessage(18, global::TienLen.Gen.CardList.Parser), 74);
+    private readonly pbc::MapField<string, global::TienLen.Gen.CardList> hands_ = new pbc::MapField<string, global::TienLen.Gen.CardList>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::MapField<string, global::TienLen.Gen.CardList> Hands {
+      get { return hands_; }
+    }
+
+    /// <summary>Field number for the "hand_versions" field.</summary>
+    public const int HandVersionsFieldNumber = 10;
+    private static readonly pbc::MapField<string, int>.Codec _map_handVersions_codec
+        = new pbc::MapField<string, int>.Codec(pb::FieldCodec.ForString(10, ""), pb::FieldCodec.ForInt32(16, 0), 82);
+    private readonly pbc::MapField<string, int> handVersions_ = new pbc::MapField<string, int>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::MapField<string, int> HandVersions {
+      get { return handVersions_; }
+    }
+
+    /// <summary>Field number for the "deck" field.</summary>
+    public const int DeckFieldNumber = 11;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_deck_codec
+        = pb::FieldCodec.ForMessage(90, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> deck_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Deck {
+      get { return deck_; }
+    }
+
+    /// <summary>Field number for the "commitment" field.</summary>
+    public const int CommitmentFieldNumber = 12;
+    private global::TienLen.Gen.DealReveal commitment_;
+    /// <summary>
+    /// Set for committed deals; players and deck are left empty
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::TienLen.Gen.DealReveal Commitment {
+      get { return commitment_; }
+      set {
+        commitment_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "board" field.</summary>
+    public const int BoardFieldNumber = 13;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_board_codec
+        = pb::FieldCodec.ForMessage(106, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> board_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Board {
+      get { return board_; }
+    }
+
+    /// <summary>Field number for the "last_actor" field.</summary>
+    public const int LastActorFieldNumber = 14;
+    private string lastActor_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string LastActor {
+      get { return lastActor_; }
+      set {
+        lastActor_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "round_skippers" field.</summary>
+    public const int RoundSkippersFieldNumber = 15;
+    private static readonly pb::FieldCodec<string> _repeated_roundSkippers_codec
+        = pb::FieldCodec.ForString(122);
+    private readonly pbc::RepeatedField<string> roundSkippers_ = new pbc::RepeatedField<string>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> RoundSkippers {
+      get { return roundSkippers_; }
+    }
+
+    /// <summary>Field number for the "chop_chain_open" field.</summary>
+    public const int ChopChainOpenFieldNumber = 16;
+    private bool chopChainOpen_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool ChopChainOpen {
+      get { return chopChainOpen_; }
+      set {
+        chopChainOpen_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "winners" field.</summary>
+    public const int WinnersFieldNumber = 17;
+    private static readonly pb::FieldCodec<string> _repeated_winners_codec
+        = pb::FieldCodec.ForString(138);
+    private readonly pbc::RepeatedField<string> winners_ = new pbc::RepeatedField<string>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> Winners {
+      get { return winners_; }
+    }
+
+    /// <summary>Field number for the "finished_players" field.</summary>
+    public const int FinishedPlayersFieldNumber = 18;
+    private static readonly pb::FieldCodec<string> _repeated_finishedPlayers_codec
+        = pb::FieldCodec.ForString(146);
+    private readonly pbc::RepeatedField<string> finishedPlayers_ = new pbc::RepeatedField<string>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> FinishedPlayers {
+      get { return finishedPlayers_; }
+    }
+
+    /// <summary>Field number for the "chops" field.</summary>
+    public const int ChopsFieldNumber = 19;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.ChopPacket> _repeated_chops_codec
+        = pb::FieldCodec.ForMessage(154, global::TienLen.Gen.ChopPacket.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.ChopPacket> chops_ = new pbc::RepeatedField<global::TienLen.Gen.ChopPacket>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.ChopPacket> Chops {
+      get { return chops_; }
+    }
+
+    /// <summary>Field number for the "cards_played" field.</summary>
+    public const int CardsPlayedFieldNumber = 20;
+    private static readonly pbc::MapField<string, int>.Codec _map_cardsPlayed_codec
+        = new pbc::MapField<string, int>.Codec(pb::FieldCodec.ForString(10, ""), pb::FieldCodec.ForInt32(16, 0), 162);
+    private readonly pbc::MapField<string, int> cardsPlayed_ = new pbc::MapField<string, int>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::MapField<string, int> CardsPlayed {
+      get { return cardsPlayed_; }
+    }
+
+    /// <summary>Field number for the "ended_by_instant_win" field.</summary>
+    public const int EndedByInstantWinFieldNumber = 21;
+    private bool endedByInstantWin_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool EndedByInstantWin {
+      get { return endedByInstantWin_; }
+      set {
+        endedByInstantWin_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "log" field.</summary>
+    public const int LogFieldNumber = 22;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.LogEntry> _repeated_log_codec
+        = pb::FieldCodec.ForMessage(178, global::TienLen.Gen.LogEntry.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.LogEntry> log_ = new pbc::RepeatedField<global::TienLen.Gen.LogEntry>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.LogEntry> Log {
+      get { return log_; }
+    }
+
+    /// <summary>Field number for the "deal" field.</summary>
+    public const int DealFieldNumber = 23;
+    private global::TienLen.Gen.DealConfig deal_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::TienLen.Gen.DealConfig Deal {
+      get { return deal_; }
+      set {
+        deal_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "hand_size" field.</summary>
+    public const int HandSizeFieldNumber = 24;
+    private int handSize_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int HandSize {
+      get { return handSize_; }
+      set {
+        handSize_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "opening_card" field.</summary>
+    public const int OpeningCardFieldNumber = 25;
+    private global::TienLen.Gen.Card openingCard_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::TienLen.Gen.Card OpeningCard {
+      get { return openingCard_; }
+      set {
+        openingCard_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "abandoned" field.</summary>
+    public const int AbandonedFieldNumber = 26;
+    private static readonly pb::FieldCodec<string> _repeated_abandoned_codec
+        = pb::FieldCodec.ForString(210);
+    private readonly pbc::RepeatedField<string> abandoned_ = new pbc::RepeatedField<string>();
+    /// <summary>
+    /// Players who left mid-game; bots play their hands
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> Abandoned {
+      get { return abandoned_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as GameState);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(GameState other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Version != other.Version) return false;
+      if (Variant != other.Variant) return false;
+      if (Seed != other.Seed) return false;
+      if (IsPlaying != other.IsPlaying) return false;
+      if (OwnerId != other.OwnerId) return false;
+      if(!players_.Equals(other.players_)) return false;
+      if(!turnOrder_.Equals(other.turnOrder_)) return false;
+      if (CurrentIdx != other.CurrentIdx) return false;
+      if (!Hands.Equals(other.Hands)) return false;
+      if (!HandVersions.Equals(other.HandVersions)) return false;
+      if(!deck_.Equals(other.deck_)) return false;
+      if (!object.Equals(Commitment, other.Commitment)) return false;
+      if(!board_.Equals(other.board_)) return false;
+      if (LastActor != other.LastActor) return false;
+      if(!roundSkippers_.Equals(other.roundSkippers_)) return false;
+      if (ChopChainOpen != other.ChopChainOpen) return false;
+      if(!winners_.Equals(other.winners_)) return false;
+      if(!finishedPlayers_.Equals(other.finishedPlayers_)) return false;
+      if(!chops_.Equals(other.chops_)) return false;
+      if (!CardsPlayed.Equals(other.CardsPlayed)) return false;
+      if (EndedByInstantWin != other.EndedByInstantWin) return false;
+      if(!log_.Equals(other.log_)) return false;
+      if (!object.Equals(Deal, other.Deal)) return false;
+      if (HandSize != other.HandSize) return false;
+      if (!object.Equals(OpeningCard, other.OpeningCard)) return false;
+      if(!abandoned_.Equals(other.abandoned_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Version != 0) hash ^= Version.GetHashCode();
+      if (Variant.Length != 0) hash ^= Variant.GetHashCode();
+      if (Seed != 0L) hash ^= Seed.GetHashCode();
+      if (IsPlaying != false) hash ^= IsPlaying.GetHashCode();
+      if (OwnerId.Length != 0) hash ^= OwnerId.GetHashCode();
+      hash ^= players_.GetHashCode();
+      hash ^= turnOrder_.GetHashCode();
+      if (CurrentIdx != 0) hash ^= CurrentIdx.GetHashCode();
+      hash ^= Hands.GetHashCode();
+      hash ^= HandVersions.GetHashCode();
+      hash ^= deck_.GetHashCode();
+      if (commitment_ != null) hash ^= Commitment.GetHashCode();
+      hash ^= board_.GetHashCode();
+      if (LastActor.Length != 0) hash ^= LastActor.GetHashCode();
+      hash ^= roundSkippers_.GetHashCode();
+      if (ChopChainOpen != false) hash ^= ChopChainOpen.GetHashCode();
+      hash ^= winners_.GetHashCode();
+      hash ^= finishedPlayers_.GetHashCode();
+      hash ^= chops_.GetHashCode();
+      hash ^= CardsPlayed.GetHashCode();
+      if (EndedByInstantWin != false) hash ^= EndedByInstantWin.GetHashCode();
+      hash ^= log_.GetHashCode();
+      if (deal_ != null) hash ^= Deal.GetHashCode();
+      if (HandSize != 0) hash ^= HandSize.GetHashCode();
+      if (openingCard_ != null) hash ^= OpeningCard.GetHashCode();
+      hash ^= abandoned_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Version != 0) {
+        output.WriteRawTag(8);
+        output.WriteInt32(Version);
+      }
+      if (Variant.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Variant);
+      }
+      if (Seed != 0L) {
+        output.WriteRawTag(24);
+        output.WriteInt64(Seed);
+      }
+      if (IsPlaying != false) {
+        output.WriteRawTag(32);
+        output.WriteBool(IsPlaying);
+      }
+      if (OwnerId.Length != 0) {
+        output.WriteRawTag(42);
+        output.WriteString(OwnerId);
+      }
+      players_.WriteTo(output, _repeated_players_codec);
+      turnOrder_.WriteTo(output, _repeated_turnOrder_codec);
+      if (CurrentIdx != 0) {
+        output.WriteRawTag(64);
+        output.WriteInt32(CurrentIdx);
+      }
+      hands_.WriteTo(output, _map_hands_codec);
+      handVersions_.WriteTo(output, _map_handVersions_codec);
+      deck_.WriteTo(output, _repeated_deck_codec);
+      if (commitment_ != null) {
+        output.WriteRawTag(98);
+        output.WriteMessage(Commitment);
+      }
+      board_.WriteTo(output, _repeated_board_codec);
+      if (LastActor.Length != 0) {
+        output.WriteRawTag(114);
+        output.WriteString(LastActor);
+      }
+      roundSkippers_.WriteTo(output, _repeated_roundSkippers_codec);
+      if (ChopChainOpen != false) {
+        output.WriteRawTag(128, 1);
+        output.WriteBool(ChopChainOpen);
+      }
+      winners_.WriteTo(output, _repeated_winners_codec);
+      finishedPlayers_.WriteTo(output, _repeated_finishedPlayers_codec);
+      chops_.WriteTo(output, _repeated_chops_codec);
+      cardsPlayed_.WriteTo(output, _map_cardsPlayed_codec);
+      if (EndedByInstantWin != false) {
+        output.WriteRawTag(168, 1);
+        output.WriteBool(EndedByInstantWin);
+      }
+      log_.WriteTo(output, _repeated_log_codec);
+      if (deal_ != null) {
+        output.WriteRawTag(186, 1);
+        output.WriteMessage(Deal);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(192, 1);
+        output.WriteInt32(HandSize);
+      }
+      if (openingCard_ != null) {
+        output.WriteRawTag(202, 1);
+        output.WriteMessage(OpeningCard);
+      }
+      abandoned_.WriteTo(output, _repeated_abandoned_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Version != 0) {
+        output.WriteRawTag(8);
+        output.WriteInt32(Version);
+      }
+      if (Variant.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Variant);
+      }
+      if (Seed != 0L) {
+        output.WriteRawTag(24);
+        output.WriteInt64(Seed);
+      }
+      if (IsPlaying != false) {
+        output.WriteRawTag(32);
+        output.WriteBool(IsPlaying);
+      }
+      if (OwnerId.Length != 0) {
+        output.WriteRawTag(42);
+        output.WriteString(OwnerId);
+      }
+      players_.WriteTo(ref output, _repeated_players_codec);
+      turnOrder_.WriteTo(ref output, _repeated_turnOrder_codec);
+      if (CurrentIdx != 0) {
+        output.WriteRawTag(64);
+        output.WriteInt32(CurrentIdx);
+      }
+      hands_.WriteTo(ref output, _map_hands_codec);
+      handVersions_.WriteTo(ref output, _map_handVersions_codec);
+      deck_.WriteTo(ref output, _repeated_deck_codec);
+      if (commitment_ != null) {
+        output.WriteRawTag(98);
+        output.WriteMessage(Commitment);
+      }
+      board_.WriteTo(ref output, _repeated_board_codec);
+      if (LastActor.Length != 0) {
+        output.WriteRawTag(114);
+        output.WriteString(LastActor);
+      }
+      roundSkippers_.WriteTo(ref output, _repeated_roundSkippers_codec);
+      if (ChopChainOpen != false) {
+        output.WriteRawTag(128, 1);
+        output.WriteBool(ChopChainOpen);
+      }
+      winners_.WriteTo(ref output, _repeated_winners_codec);
+      finishedPlayers_.WriteTo(ref output, _repeated_finishedPlayers_codec);
+      chops_.WriteTo(ref output, _repeated_chops_codec);
+      cardsPlayed_.WriteTo(ref output, _map_cardsPlayed_codec);
+      if (EndedByInstantWin != false) {
+        output.WriteRawTag(168, 1);
+        output.WriteBool(EndedByInstantWin);
+      }
+      log_.WriteTo(ref output, _repeated_log_codec);
+      if (deal_ != null) {
+        output.WriteRawTag(186, 1);
+        output.WriteMessage(Deal);
+      }
+      if (HandSize != 0) {
+        output.WriteRawTag(192, 1);
+        output.WriteInt32(HandSize);
+      }
+      if (openingCard_ != null) {
+        output.WriteRawTag(202, 1);
+        output.WriteMessage(OpeningCard);
+      }
+      abandoned_.WriteTo(ref output, _repeated_abandoned_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Version != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(Version);
+      }
+      if (Variant.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Variant);
+      }
+      if (Seed != 0L) {
+        size += 1 + pb::CodedOutputStream.ComputeInt64Size(Seed);
+      }
+      if (IsPlaying != false) {
+        size += 1 + 1;
+      }
+      if (OwnerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(OwnerId);
+      }
+      size += players_.CalculateSize(_repeated_players_codec);
+      size += turnOrder_.CalculateSize(_repeated_turnOrder_codec);
+      if (CurrentIdx != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(CurrentIdx);
+      }
+      size += hands_.CalculateSize(_map_hands_codec);
+      size += handVersions_.CalculateSize(_map_handVersions_codec);
+      size += deck_.CalculateSize(_repeated_deck_codec);
+      if (commitment_ != null) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Commitment);
+      }
+      size += board_.CalculateSize(_repeated_board_codec);
+      if (LastActor.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(LastActor);
+      }
+      size += roundSkippers_.CalculateSize(_repeated_roundSkippers_codec);
+      if (ChopChainOpen != false) {
+        size += 2 + 1;
+      }
+      size += winners_.CalculateSize(_repeated_winners_codec);
+      size += finishedPlayers_.CalculateSize(_repeated_finishedPlayers_codec);
+      size += chops_.CalculateSize(_repeated_chops_codec);
+      size += cardsPlayed_.CalculateSize(_map_cardsPlayed_codec);
+      if (EndedByInstantWin != false) {
+        size += 2 + 1;
+      }
+      size += log_.CalculateSize(_repeated_log_codec);
+      if (deal_ != null) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(Deal);
+      }
+      if (HandSize != 0) {
+        size += 2 + pb::CodedOutputStream.ComputeInt32Size(HandSize);
+      }
+      if (openingCard_ != null) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(OpeningCard);
+      }
+      size += abandoned_.CalculateSize(_repeated_abandoned_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(GameState other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Version != 0) {
+        Version = other.Version;
+      }
+      if (other.Variant.Length != 0) {
+        Variant = other.Variant;
+      }
+      if (other.Seed != 0L) {
+        Seed = other.Seed;
+      }
+      if (other.IsPlaying != false) {
+        IsPlaying = other.IsPlaying;
+      }
+      if (other.OwnerId.Length != 0) {
+        OwnerId = other.OwnerId;
+      }
+      players_.Add(other.players_);
+      turnOrder_.Add(other.turnOrder_);
+      if (other.CurrentIdx != 0) {
+        CurrentIdx = other.CurrentIdx;
+      }
+      hands_.MergeFrom(other.hands_);
+      handVersions_.MergeFrom(other.handVersions_);
+      deck_.Add(other.deck_);
+      if (other.commitment_ != null) {
+        if (commitment_ == null) {
+          Commitment = new global::TienLen.Gen.DealReveal();
+        }
+        Commitment.MergeFrom(other.Commitment);
+      }
+      board_.Add(other.board_);
+      if (other.LastActor.Length != 0) {
+        LastActor = other.LastActor;
+      }
+      roundSkippers_.Add(other.roundSkippers_);
+      if (other.ChopChainOpen != false) {
+        ChopChainOpen = other.ChopChainOpen;
+      }
+      winners_.Add(other.winners_);
+      finishedPlayers_.Add(other.finishedPlayers_);
+      chops_.Add(other.chops_);
+      cardsPlayed_.MergeFrom(other.cardsPlayed_);
+      if (other.EndedByInstantWin != false) {
+        EndedByInstantWin = other.EndedByInstantWin;
+      }
+      log_.Add(other.log_);
+      if (other.deal_ != null) {
+        if (deal_ == null) {
+          Deal = new global::TienLen.Gen.DealConfig();
+        }
+        Deal.MergeFrom(other.Deal);
+      }
+      if (other.HandSize != 0) {
+        HandSize = other.HandSize;
+      }
+      if (other.openingCard_ != null) {
+        if (openingCard_ == null) {
+          OpeningCard = new global::TienLen.Gen.Card();
+        }
+        OpeningCard.MergeFrom(other.OpeningCard);
+      }
+      abandoned_.Add(other.abandoned_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Version = input.ReadInt32();
+            break;
+          }
+          case 18: {
+            Variant = input.ReadString();
+            break;
+          }
+          case 24: {
+            Seed = input.ReadInt64();
+            break;
+          }
+          case 32: {
+            IsPlaying = input.ReadBool();
+            break;
+          }
+          case 42: {
+            OwnerId = input.ReadString();
+            break;
+          }
+          case 50: {
+            players_.AddEntriesFrom(input, _repeated_players_codec);
+            break;
+          }
+          case 58: {
+            turnOrder_.AddEntriesFrom(input, _repeated_turnOrder_codec);
+            break;
+          }
+          case 64: {
+            CurrentIdx = input.ReadInt32();
+            break;
+          }
+          case 74: {
+            hands_.AddEntriesFrom(input, _map_hands_codec);
+            break;
+          }
+          case 82: {
+            handVersions_.AddEntriesFrom(input, _map_handVersions_codec);
+            break;
+          }
+          case 90: {
+            deck_.AddEntriesFrom(input, _repeated_deck_codec);
+            break;
+          }
+          case 98: {
+            if (commitment_ == null) {
+              Commitment = new global::TienLen.Gen.DealReveal();
+            }
+            input.ReadMessage(Commitment);
+            break;
+          }
+          case 106: {
+            board_.AddEntriesFrom(input, _repeated_board_codec);
+            break;
+          }
+          case 114: {
+            LastActor = input.ReadString();
+            break;
+          }
+          case 122: {
+            roundSkippers_.AddEntriesFrom(input, _repeated_roundSkippers_codec);
+            break;
+          }
+          case 128: {
+            ChopChainOpen = input.ReadBool();
+            break;
+          }
+          case 138: {
+            winners_.AddEntriesFrom(input, _repeated_winners_codec);
+            break;
+          }
+          case 146: {
+            finishedPlayers_.AddEntriesFrom(input, _repeated_finishedPlayers_codec);
+            break;
+          }
+          case 154: {
+            chops_.AddEntriesFrom(input, _repeated_chops_codec);
+            break;
+          }
+          case 162: {
+            cardsPlayed_.AddEntriesFrom(input, _map_cardsPlayed_codec);
+            break;
+          }
+          case 168: {
+            EndedByInstantWin = input.ReadBool();
+            break;
+          }
+          case 178: {
+            log_.AddEntriesFrom(input, _repeated_log_codec);
+            break;
+          }
+          case 186: {
+            if (deal_ == null) {
+              Deal = new global::TienLen.Gen.DealConfig();
+            }
+            input.ReadMessage(Deal);
+            break;
+          }
+          case 192: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 202: {
+            if (openingCard_ == null) {
+              OpeningCard = new global::TienLen.Gen.Card();
+            }
+            input.ReadMessage(OpeningCard);
+            break;
+          }
+          case 210: {
+            abandoned_.AddEntriesFrom(input, _repeated_abandoned_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Version = input.ReadInt32();
+            break;
+          }
+          case 18: {
+            Variant = input.ReadString();
+            break;
+          }
+          case 24: {
+            Seed = input.ReadInt64();
+            break;
+          }
+          case 32: {
+            IsPlaying = input.ReadBool();
+            break;
+          }
+          case 42: {
+            OwnerId = input.ReadString();
+            break;
+          }
+          case 50: {
+            players_.AddEntriesFrom(ref input, _repeated_players_codec);
+            break;
+          }
+          case 58: {
+            turnOrder_.AddEntriesFrom(ref input, _repeated_turnOrder_codec);
+            break;
+          }
+          case 64: {
+            CurrentIdx = input.ReadInt32();
+            break;
+          }
+          case 74: {
+            hands_.AddEntriesFrom(ref input, _map_hands_codec);
+            break;
+          }
+          case 82: {
+            handVersions_.AddEntriesFrom(ref input, _map_handVersions_codec);
+            break;
+          }
+          case 90: {
+            deck_.AddEntriesFrom(ref input, _repeated_deck_codec);
+            break;
+          }
+          case 98: {
+            if (commitment_ == null) {
+              Commitment = new global::TienLen.Gen.DealReveal();
+            }
+            input.ReadMessage(Commitment);
+            break;
+          }
+          case 106: {
+            board_.AddEntriesFrom(ref input, _repeated_board_codec);
+            break;
+          }
+          case 114: {
+            LastActor = input.ReadString();
+            break;
+          }
+          case 122: {
+            roundSkippers_.AddEntriesFrom(ref input, _repeated_roundSkippers_codec);
+            break;
+          }
+          case 128: {
+            ChopChainOpen = input.ReadBool();
+            break;
+          }
+          case 138: {
+            winners_.AddEntriesFrom(ref input, _repeated_winners_codec);
+            break;
+          }
+          case 146: {
+            finishedPlayers_.AddEntriesFrom(ref input, _repeated_finishedPlayers_codec);
+            break;
+          }
+          case 154: {
+            chops_.AddEntriesFrom(ref input, _repeated_chops_codec);
+            break;
+          }
+          case 162: {
+            cardsPlayed_.AddEntriesFrom(ref input, _map_cardsPlayed_codec);
+            break;
+          }
+          case 168: {
+            EndedByInstantWin = input.ReadBool();
+            break;
+          }
+          case 178: {
+            log_.AddEntriesFrom(ref input, _repeated_log_codec);
+            break;
+          }
+          case 186: {
+            if (deal_ == null) {
+              Deal = new global::TienLen.Gen.DealConfig();
+            }
+            input.ReadMessage(Deal);
+            break;
+          }
+          case 192: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 202: {
+            if (openingCard_ == null) {
+              OpeningCard = new global::TienLen.Gen.Card();
+            }
+            input.ReadMessage(OpeningCard);
+            break;
+          }
+          case 210: {
+            abandoned_.AddEntriesFrom(ref input, _repeated_abandoned_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// How the deck is dealt; see MatchStatePacket.player_count for small tables.
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class DealConfig : pb::IMessage<DealConfig>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<DealConfig> _parser = new pb::MessageParser<DealConfig>(() => new DealConfig());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<DealConfig> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[20]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public DealConfig() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public DealConfig(DealConfig other) : this() {
+      handSize_ = other.handSize_;
+      dealAll_ = other.dealAll_;
+      direction_ = other.direction_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public DealConfig Clone() {
+      return new DealConfig(this);
+    }
+
+    /// <summary>Field number for the "hand_size" field.</summary>
+    public const int HandSizeFieldNumber = 1;
+    private int handSize_;
+    /// <summary>
+    /// 0 means 13
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int HandSize {
+      get { return handSize_; }
+      set {
+        handSize_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "deal_all" field.</summary>
+    public const int DealAllFieldNumber = 2;
+    private bool dealAll_;
+    /// <summary>
+    /// Share out the whole deck
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool DealAll {
+      get { return dealAll_; }
+      set {
+        dealAll_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "direction" field.</summary>
+    public const int DirectionFieldNumber = 3;
+    private string direction_ = "";
+    /// <summary>
+    /// "counter_clockwise" (default) or "clockwise"
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Direction {
+      get { return direction_; }
+      set {
+        direction_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as DealConfig);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(DealConfig other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (HandSize != other.HandSize) return false;
+      if (DealAll != other.DealAll) return false;
+      if (Direction != other.Direction) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (HandSize != 0) hash ^= HandSize.GetHashCode();
+      if (DealAll != false) hash ^= DealAll.GetHashCode();
+      if (Direction.Length != 0) hash ^= Direction.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (HandSize != 0) {
+        output.WriteRawTag(8);
+        output.WriteInt32(HandSize);
+      }
+      if (DealAll != false) {
+        output.WriteRawTag(16);
+        output.WriteBool(DealAll);
+      }
+      if (Direction.Length != 0) {
+        output.WriteRawTag(26);
+        output.WriteString(Direction);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (HandSize != 0) {
+        output.WriteRawTag(8);
+        output.WriteInt32(HandSize);
+      }
+      if (DealAll != false) {
+        output.WriteRawTag(16);
+        output.WriteBool(DealAll);
+      }
+      if (Direction.Length != 0) {
+        output.WriteRawTag(26);
+        output.WriteString(Direction);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (HandSize != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(HandSize);
+      }
+      if (DealAll != false) {
+        size += 1 + 1;
+      }
+      if (Direction.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Direction);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(DealConfig other) {
+      if (other == null) {
+        return;
+      }
+      if (other.HandSize != 0) {
+        HandSize = other.HandSize;
+      }
+      if (other.DealAll != false) {
+        DealAll = other.DealAll;
+      }
+      if (other.Direction.Length != 0) {
+        Direction = other.Direction;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 16: {
+            DealAll = input.ReadBool();
+            break;
+          }
+          case 26: {
+            Direction = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            HandSize = input.ReadInt32();
+            break;
+          }
+          case 16: {
+            DealAll = input.ReadBool();
+            break;
+          }
+          case 26: {
+            Direction = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// One accepted command of a game's log; replaying the log rebuilds the game.
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class LogEntry : pb::IMessage<LogEntry>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<LogEntry> _parser = new pb::MessageParser<LogEntry>(() => new LogEntry());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<LogEntry> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[21]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public LogEntry() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public LogEntry(LogEntry other) : this() {
+      seq_ = other.seq_;
+      kind_ = other.kind_;
+      playerId_ = other.playerId_;
+      cards_ = other.cards_.Clone();
+      variant_ = other.variant_;
+      seed_ = other.seed_;
+      playerIds_ = other.playerIds_.Clone();
+      ownerId_ = other.ownerId_;
+      lastWinnerId_ = other.lastWinnerId_;
+      commitment_ = other.commitment_ != null ? other.commitment_.Clone() : null;
+      deal_ = other.deal_ != null ? other.deal_.Clone() : null;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public LogEntry Clone() {
+      return new LogEntry(this);
+    }
+
+    /// <summary>Field number for the "seq" field.</summary>
+    public const int SeqFieldNumber = 1;
+    private int seq_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int Seq {
+      get { return seq_; }
+      set {
+        seq_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "kind" field.</summary>
+    public const int KindFieldNumber = 2;
+    private string kind_ = "";
+    /// <summary>
+    /// "start", "play", "pass", "timeout" or "leave"
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Kind {
+      get { return kind_; }
+      set {
+        kind_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 3;
+    private string playerId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "cards" field.</summary>
+    public const int CardsFieldNumber = 4;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_cards_codec
+        = pb::FieldCodec.ForMessage(34, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> cards_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
+    /// <summary>
+    /// Cards played, by value
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Cards {
+      get { return cards_; }
+    }
+
+    /// <summary>Field number for the "variant" field.</summary>
+    public const int VariantFieldNumber = 5;
+    private string variant_ = "";
+    /// <summary>
+    /// Start parameters.
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Variant {
+      get { return variant_; }
+      set {
+        variant_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "seed" field.</summary>
+    public const int SeedFieldNumber = 6;
+    private long seed_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public long Seed {
+      get { return seed_; }
+      set {
+        seed_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "player_ids" field.</summary>
+    public const int PlayerIdsFieldNumber = 7;
+    private static readonly pb::FieldCodec<string> _repeated_playerIds_codec
+        = pb::FieldCodec.ForString(58);
+    private readonly pbc::RepeatedField<string> playerIds_ = new pbc::RepeatedField<string>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<string> PlayerIds {
+      get { return playerIds_; }
+    }
+
+    /// <summary>Field number for the "owner_id" field.</summary>
+    public const int OwnerIdFieldNumber = 8;
+    private string ownerId_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string OwnerId {
+      get { return ownerId_; }
+      set {
+        ownerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "last_winner_id" field.</summary>
+    public const int LastWinnerIdFieldNumber = 9;
+    private string lastWinnerId_ = "";
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public PlayCardRequest(PlayCardRequest other) : this() {
-      cardIndices_ = other.cardIndices_.Clone();
-      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    public string LastWinnerId {
+      get { return lastWinnerId_; }
+      set {
+        lastWinnerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
     }
 
+    /// <summary>Field number for the "commitment" field.</summary>
+    public const int CommitmentFieldNumber = 10;
+    private global::TienLen.Gen.DealReveal commitment_;
+    /// <summary>
+    /// Set for committed deals; players and deck are left empty
+    /// </summary>
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public PlayCardRequest Clone() {
-      return new PlayCardRequest(this);
+    public global::TienLen.Gen.DealReveal Commitment {
+      get { return commitment_; }
+      set {
+        commitment_ = value;
+      }
     }
 
-    /// <summary>Field number for the "card_indices" field.</summary>
-    public const int CardIndicesFieldNumber = 1;
-    private static readonly pb::FieldCodec<int> _repeated_cardIndices_codec
-        = pb::FieldCodec.ForInt32(10);
-    private readonly pbc::RepeatedField<int> cardIndices_ = new pbc::RepeatedField<int>();
-    /// <summary>
-    /// Indices of cards in hand to play
-    /// </summary>
+    /// <summary>Field number for the "deal" field.</summary>
+    public const int DealFieldNumber = 11;
+    private global::TienLen.Gen.DealConfig deal_;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public pbc::RepeatedField<int> CardIndices {
-      get { return cardIndices_; }
+    public global::TienLen.Gen.DealConfig Deal {
+      get { return deal_; }
+      set {
+        deal_ = value;
+      }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as PlayCardRequest);
+      return Equals(other as LogEntry);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(PlayCardRequest other) {
+    public bool Equals(LogEntry other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if(!cardIndices_.Equals(other.cardIndices_)) return false;
+      if (Seq != other.Seq) return false;
+      if (Kind != other.Kind) return false;
+      if (PlayerId != other.PlayerId) return false;
+      if(!cards_.Equals(other.cards_)) return false;
+      if (Variant != other.Variant) return false;
+      if (Seed != other.Seed) return false;
+      if(!playerIds_.Equals(other.playerIds_)) return false;
+      if (OwnerId != other.OwnerId) return false;
+      if (LastWinnerId != other.LastWinnerId) return false;
+      if (!object.Equals(Commitment, other.Commitment)) return false;
+      if (!object.Equals(Deal, other.Deal)) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -1611,7 +8441,17 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      hash ^= cardIndices_.GetHashCode();
+      if (Seq != 0) hash ^= Seq.GetHashCode();
+      if (Kind.Length != 0) hash ^= Kind.GetHashCode();
+      if (PlayerId.Length != 0) hash ^= PlayerId.GetHashCode();
+      hash ^= cards_.GetHashCode();
+      if (Variant.Length != 0) hash ^= Variant.GetHashCode();
+      if (Seed != 0L) hash ^= Seed.GetHashCode();
+      hash ^= playerIds_.GetHashCode();
+      if (OwnerId.Length != 0) hash ^= OwnerId.GetHashCode();
+      if (LastWinnerId.Length != 0) hash ^= LastWinnerId.GetHashCode();
+      if (commitment_ != null) hash ^= Commitment.GetHashCode();
+      if (deal_ != null) hash ^= Deal.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -1630,7 +8470,44 @@ namespace TienLen.Gen {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      cardIndices_.WriteTo(output, _repeated_cardIndices_codec);
+      if (Seq != 0) {
+        output.WriteRawTag(8);
+        output.WriteInt32(Seq);
+      }
+      if (Kind.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Kind);
+      }
+      if (PlayerId.Length != 0) {
+        output.WriteRawTag(26);
+        output.WriteString(PlayerId);
+      }
+      cards_.WriteTo(output, _repeated_cards_codec);
+      if (Variant.Length != 0) {
+        output.WriteRawTag(42);
+        output.WriteString(Variant);
+      }
+      if (Seed != 0L) {
+        output.WriteRawTag(48);
+        output.WriteInt64(Seed);
+      }
+      playerIds_.WriteTo(output, _repeated_playerIds_codec);
+      if (OwnerId.Length != 0) {
+        output.WriteRawTag(66);
+        output.WriteString(OwnerId);
+      }
+      if (LastWinnerId.Length != 0) {
+        output.WriteRawTag(74);
+        output.WriteString(LastWinnerId);
+      }
+      if (commitment_ != null) {
+        output.WriteRawTag(82);
+        output.WriteMessage(Commitment);
+      }
+      if (deal_ != null) {
+        output.WriteRawTag(90);
+        output.WriteMessage(Deal);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -1641,7 +8518,44 @@ namespace TienLen.Gen {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      cardIndices_.WriteTo(ref output, _repeated_cardIndices_codec);
+      if (Seq != 0) {
+        output.WriteRawTag(8);
+        output.WriteInt32(Seq);
+      }
+      if (Kind.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Kind);
+      }
+      if (PlayerId.Length != 0) {
+        output.WriteRawTag(26);
+        output.WriteString(PlayerId);
+      }
+      cards_.WriteTo(ref output, _repeated_cards_codec);
+      if (Variant.Length != 0) {
+        output.WriteRawTag(42);
+        output.WriteString(Variant);
+      }
+      if (Seed != 0L) {
+        output.WriteRawTag(48);
+        output.WriteInt64(Seed);
+      }
+      playerIds_.WriteTo(ref output, _repeated_playerIds_codec);
+      if (OwnerId.Length != 0) {
+        output.WriteRawTag(66);
+        output.WriteString(OwnerId);
+      }
+      if (LastWinnerId.Length != 0) {
+        output.WriteRawTag(74);
+        output.WriteString(LastWinnerId);
+      }
+      if (commitment_ != null) {
+        output.WriteRawTag(82);
+        output.WriteMessage(Commitment);
+      }
+      if (deal_ != null) {
+        output.WriteRawTag(90);
+        output.WriteMessage(Deal);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -1652,7 +8566,35 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      size += cardIndices_.CalculateSize(_repeated_cardIndices_codec);
+      if (Seq != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeInt32Size(Seq);
+      }
+      if (Kind.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Kind);
+      }
+      if (PlayerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(PlayerId);
+      }
+      size += cards_.CalculateSize(_repeated_cards_codec);
+      if (Variant.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Variant);
+      }
+      if (Seed != 0L) {
+        size += 1 + pb::CodedOutputStream.ComputeInt64Size(Seed);
+      }
+      size += playerIds_.CalculateSize(_repeated_playerIds_codec);
+      if (OwnerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(OwnerId);
+      }
+      if (LastWinnerId.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(LastWinnerId);
+      }
+      if (commitment_ != null) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Commitment);
+      }
+      if (deal_ != null) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Deal);
+      }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -1661,11 +8603,45 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(PlayCardRequest other) {
+    public void MergeFrom(LogEntry other) {
       if (other == null) {
         return;
       }
-      cardIndices_.Add(other.cardIndices_);
+      if (other.Seq != 0) {
+        Seq = other.Seq;
+      }
+      if (other.Kind.Length != 0) {
+        Kind = other.Kind;
+      }
+      if (other.PlayerId.Length != 0) {
+        PlayerId = other.PlayerId;
+      }
+      cards_.Add(other.cards_);
+      if (other.Variant.Length != 0) {
+        Variant = other.Variant;
+      }
+      if (other.Seed != 0L) {
+        Seed = other.Seed;
+      }
+      playerIds_.Add(other.playerIds_);
+      if (other.OwnerId.Length != 0) {
+        OwnerId = other.OwnerId;
+      }
+      if (other.LastWinnerId.Length != 0) {
+        LastWinnerId = other.LastWinnerId;
+      }
+      if (other.commitment_ != null) {
+        if (commitment_ == null) {
+          Commitment = new global::TienLen.Gen.DealReveal();
+        }
+        Commitment.MergeFrom(other.Commitment);
+      }
+      if (other.deal_ != null) {
+        if (deal_ == null) {
+          Deal = new global::TienLen.Gen.DealConfig();
+        }
+        Deal.MergeFrom(other.Deal);
+      }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -1685,9 +8661,54 @@ namespace TienLen.Gen {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
-          case 10:
           case 8: {
-            cardIndices_.AddEntriesFrom(input, _repeated_cardIndices_codec);
+            Seq = input.ReadInt32();
+            break;
+          }
+          case 18: {
+            Kind = input.ReadString();
+            break;
+          }
+          case 26: {
+            PlayerId = input.ReadString();
+            break;
+          }
+          case 34: {
+            cards_.AddEntriesFrom(input, _repeated_cards_codec);
+            break;
+          }
+          case 42: {
+            Variant = input.ReadString();
+            break;
+          }
+          case 48: {
+            Seed = input.ReadInt64();
+            break;
+          }
+          case 58: {
+            playerIds_.AddEntriesFrom(input, _repeated_playerIds_codec);
+            break;
+          }
+          case 66: {
+            OwnerId = input.ReadString();
+            break;
+          }
+          case 74: {
+            LastWinnerId = input.ReadString();
+            break;
+          }
+          case 82: {
+            if (commitment_ == null) {
+              Commitment = new global::TienLen.Gen.DealReveal();
+            }
+            input.ReadMessage(Commitment);
+            break;
+          }
+          case 90: {
+            if (deal_ == null) {
+              Deal = new global::TienLen.Gen.DealConfig();
+            }
+            input.ReadMessage(Deal);
             break;
           }
         }
@@ -1709,9 +8730,54 @@ namespace TienLen.Gen {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
-          case 10:
           case 8: {
-            cardIndices_.AddEntriesFrom(ref input, _repeated_cardIndices_codec);
+            Seq = input.ReadInt32();
+            break;
+          }
+          case 18: {
+            Kind = input.ReadString();
+            break;
+          }
+          case 26: {
+            PlayerId = input.ReadString();
+            break;
+          }
+          case 34: {
+            cards_.AddEntriesFrom(ref input, _repeated_cards_codec);
+            break;
+          }
+          case 42: {
+            Variant = input.ReadString();
+            break;
+          }
+          case 48: {
+            Seed = input.ReadInt64();
+            break;
+          }
+          case 58: {
+            playerIds_.AddEntriesFrom(ref input, _repeated_playerIds_codec);
+            break;
+          }
+          case 66: {
+            OwnerId = input.ReadString();
+            break;
+          }
+          case 74: {
+            LastWinnerId = input.ReadString();
+            break;
+          }
+          case 82: {
+            if (commitment_ == null) {
+              Commitment = new global::TienLen.Gen.DealReveal();
+            }
+            input.ReadMessage(Commitment);
+            break;
+          }
+          case 90: {
+            if (deal_ == null) {
+              Deal = new global::TienLen.Gen.DealConfig();
+            }
+            input.ReadMessage(Deal);
             break;
           }
         }
@@ -1722,21 +8788,21 @@ namespace TienLen.Gen {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class TurnUpdatePacket : pb::IMessage<TurnUpdatePacket>
+  public sealed partial class CardList : pb::IMessage<CardList>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<TurnUpdatePacket> _parser = new pb::MessageParser<TurnUpdatePacket>(() => new TurnUpdatePacket());
+    private static readonly pb::MessageParser<CardList> _parser = new pb::MessageParser<CardList>(() => new CardList());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<TurnUpdatePacket> Parser { get { return _parser; } }
+    public static pb::MessageParser<CardList> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public static pbr::MessageDescriptor Descriptor {
-      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[7]; }
+      get { return global::TienLen.Gen.GameReflection.Descriptor.MessageTypes[22]; }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
@@ -1747,7 +8813,7 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public TurnUpdatePacket() {
+    public CardList() {
       OnConstruction();
     }
 
@@ -1755,75 +8821,44 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public TurnUpdatePacket(TurnUpdatePacket other) : this() {
-      activePlayerId_ = other.activePlayerId_;
-      lastPlayedCards_ = other.lastPlayedCards_.Clone();
-      secondsRemaining_ = other.secondsRemaining_;
+    public CardList(CardList other) : this() {
+      cards_ = other.cards_.Clone();
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public TurnUpdatePacket Clone() {
-      return new TurnUpdatePacket(this);
-    }
-
-    /// <summary>Field number for the "active_player_id" field.</summary>
-    public const int ActivePlayerIdFieldNumber = 1;
-    private string activePlayerId_ = "";
-    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
-    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public string ActivePlayerId {
-      get { return activePlayerId_; }
-      set {
-        activePlayerId_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
-      }
-    }
-
-    /// <summary>Field number for the "last_played_cards" field.</summary>
-    public const int LastPlayedCardsFieldNumber = 2;
-    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_lastPlayedCards_codec
-        = pb::FieldCodec.ForMessage(18, global::TienLen.Gen.Card.Parser);
-    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> lastPlayedCards_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
-    /// <summary>
-    /// Cards currently on table
-    /// </summary>
-    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
-    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public pbc::RepeatedField<global::TienLen.Gen.Card> LastPlayedCards {
-      get { return lastPlayedCards_; }
+    public CardList Clone() {
+      return new CardList(this);
     }
 
-    /// <summary>Field number for the "seconds_remaining" field.</summary>
-    public const int SecondsRemainingFieldNumber = 3;
-    private int secondsRemaining_;
+    /// <summary>Field number for the "cards" field.</summary>
+    public const int CardsFieldNumber = 1;
+    private static readonly pb::FieldCodec<global::TienLen.Gen.Card> _repeated_cards_codec
+        = pb::FieldCodec.ForMessage(10, global::TienLen.Gen.Card.Parser);
+    private readonly pbc::RepeatedField<global::TienLen.Gen.Card> cards_ = new pbc::RepeatedField<global::TienLen.Gen.Card>();
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public int SecondsRemaining {
-      get { return secondsRemaining_; }
-      set {
-        secondsRemaining_ = value;
-      }
+    public pbc::RepeatedField<global::TienLen.Gen.Card> Cards {
+      get { return cards_; }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as TurnUpdatePacket);
+      return Equals(other as CardList);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(TurnUpdatePacket other) {
+    public bool Equals(CardList other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if (ActivePlayerId != other.ActivePlayerId) return false;
-      if(!lastPlayedCards_.Equals(other.lastPlayedCards_)) return false;
-      if (SecondsRemaining != other.SecondsRemaining) return false;
+      if(!cards_.Equals(other.cards_)) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -1831,9 +8866,7 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      if (ActivePlayerId.Length != 0) hash ^= ActivePlayerId.GetHashCode();
-      hash ^= lastPlayedCards_.GetHashCode();
-      if (SecondsRemaining != 0) hash ^= SecondsRemaining.GetHashCode();
+      hash ^= cards_.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -1852,15 +8885,7 @@ namespace TienLen.Gen {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (ActivePlayerId.Length != 0) {
-        output.WriteRawTag(10);
-        output.WriteString(ActivePlayerId);
-      }
-      lastPlayedCards_.WriteTo(output, _repeated_lastPlayedCards_codec);
-      if (SecondsRemaining != 0) {
-        output.WriteRawTag(24);
-        output.WriteInt32(SecondsRemaining);
-      }
+      cards_.WriteTo(output, _repeated_cards_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -1871,15 +8896,7 @@ namespace TienLen.Gen {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      if (ActivePlayerId.Length != 0) {
-        output.WriteRawTag(10);
-        output.WriteString(ActivePlayerId);
-      }
-      lastPlayedCards_.WriteTo(ref output, _repeated_lastPlayedCards_codec);
-      if (SecondsRemaining != 0) {
-        output.WriteRawTag(24);
-        output.WriteInt32(SecondsRemaining);
-      }
+      cards_.WriteTo(ref output, _repeated_cards_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -1890,13 +8907,7 @@ namespace TienLen.Gen {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      if (ActivePlayerId.Length != 0) {
-        size += 1 + pb::CodedOutputStream.ComputeStringSize(ActivePlayerId);
-      }
-      size += lastPlayedCards_.CalculateSize(_repeated_lastPlayedCards_codec);
-      if (SecondsRemaining != 0) {
-        size += 1 + pb::CodedOutputStream.ComputeInt32Size(SecondsRemaining);
-      }
+      size += cards_.CalculateSize(_repeated_cards_codec);
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -1905,17 +8916,11 @@ namespace TienLen.Gen {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(TurnUpdatePacket other) {
+    public void MergeFrom(CardList other) {
       if (other == null) {
         return;
       }
-      if (other.ActivePlayerId.Length != 0) {
-        ActivePlayerId = other.ActivePlayerId;
-      }
-      lastPlayedCards_.Add(other.lastPlayedCards_);
-      if (other.SecondsRemaining != 0) {
-        SecondsRemaining = other.SecondsRemaining;
-      }
+      cards_.Add(other.cards_);
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -1936,15 +8941,7 @@ namespace TienLen.Gen {
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
           case 10: {
-            ActivePlayerId = input.ReadString();
-            break;
-          }
-          case 18: {
-            lastPlayedCards_.AddEntriesFrom(input, _repeated_lastPlayedCards_codec);
-            break;
-          }
-          case 24: {
-            SecondsRemaining = input.ReadInt32();
+            cards_.AddEntriesFrom(input, _repeated_cards_codec);
             break;
           }
         }
@@ -1967,15 +8964,7 @@ namespace TienLen.Gen {
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
           case 10: {
-            ActivePlayerId = input.ReadString();
-            break;
-          }
-          case 18: {
-            lastPlayedCards_.AddEntriesFrom(ref input, _repeated_lastPlayedCards_codec);
-            break;
-          }
-          case 24: {
-            SecondsRemaining = input.ReadInt32();
+            cards_.AddEntriesFrom(ref input, _repeated_cards_codec);
             break;
           }
         }
@@ -0,0 +1,58 @@
+// Command tienlen-sim plays bot-only Tien Len games on the pure engine and prints statistics.
+//
+// Example:
+//
+//	go run ./cmd/tienlen-sim -games 1000000 -variant northern -strategies greedy,random,greedy,random
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/yourusername/tienlen-server/internal/sim"
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+)
+
+func main() {
+	games := flag.Int("games", 10000, "number of games to play")
+	workers := flag.Int("workers", runtime.NumCPU(), "games played in parallel")
+	variant := flag.String("variant", string(tienlen.VariantSouthern), "rule variant (southern, northern)")
+	strategies := flag.String("strategies", "greedy,greedy,greedy,greedy", "comma-separated bot strategy per seat; the count sets the number of players")
+	seed := flag.Int64("seed", 0, "base seed; game i is dealt from seed+i (0 picks a random seed)")
+	flag.Parse()
+
+	rules, err := tienlen.RuleSetFor(tienlen.Variant(*variant))
+	if err != nil {
+		fail(err)
+	}
+
+	if *seed == 0 {
+		*seed = tienlen.NewSeed()
+	}
+
+	start := time.Now()
+	stats, err := sim.Run(sim.Config{
+		Games:      *games,
+		Workers:    *workers,
+		Rules:      rules,
+		Strategies: strings.Split(*strategies, ","),
+		Seed:       *seed,
+	})
+	if err != nil {
+		fail(err)
+	}
+
+	fmt.Printf("variant:        %s\n", rules.Variant())
+	fmt.Printf("seed:           %d\n", *seed)
+	stats.Report(os.Stdout)
+	fmt.Printf("\nelapsed:        %s\n", time.Since(start).Round(time.Millisecond))
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "tienlen-sim:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/yourusername/tienlen-server/internal/match"
+)
+
+// Private rooms are found by a short join code instead of quick match. Codes are drawn from
+// joinCodeAlphabet, which leaves out look-alikes such as 0/O and 1/I, and map to match IDs
+// in the match.JoinCodeCollection storage collection, owned by the system user and hidden
+// from clients.
+const (
+	joinCodeAlphabet   = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	joinCodeLength     = 6
+	joinCodeAttempts   = 5
+	joinCodeCollection = match.JoinCodeCollection
+)
+
+type joinCodeEntry struct {
+	MatchID string `json:"match_id"`
+}
+
+// newJoinCode draws a random join code.
+func newJoinCode() (string, error) {
+	buf := make([]byte, joinCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generating join code: %w", err)
+	}
+	for i, b := range buf {
+		buf[i] = joinCodeAlphabet[int(b)%len(joinCodeAlphabet)]
+	}
+	return string(buf), nil
+}
+
+// normalizeJoinCode forgives the way players type codes in: case and surrounding spaces.
+func normalizeJoinCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// reserveJoinCode claims an unused join code. The write only succeeds if no room holds the
+// code yet, so a clash is retried with a fresh code.
+func reserveJoinCode(ctx context.Context, nk runtime.NakamaModule) (string, error) {
+	var lastErr error
+	for i := 0; i < joinCodeAttempts; i++ {
+		code, err := newJoinCode()
+		if err != nil {
+			return "", err
+		}
+		if lastErr = writeJoinCode(ctx, nk, code, "", "*"); lastErr == nil {
+			return code, nil
+		}
+	}
+	return "", fmt.Errorf("no free join code after %d attempts: %w", joinCodeAttempts, lastErr)
+}
+
+// writeJoinCode points code at matchID. A version of "*" only writes a code not yet taken.
+func writeJoinCode(ctx context.Context, nk runtime.NakamaModule, code, matchID, version string) error {
+	value, err := json.Marshal(joinCodeEntry{MatchID: matchID})
+	if err != nil {
+		return err
+	}
+	_, err = nk.StorageWrite(ctx, []*runtime.StorageWrite{{
+		Collection:      joinCodeCollection,
+		Key:             code,
+		Value:           string(value),
+		Version:         version,
+		PermissionRead:  0, // No client access
+		PermissionWrite: 0,
+	}})
+	return err
+}
+
+// releaseJoinCode frees code for other rooms.
+func releaseJoinCode(ctx context.Context, nk runtime.NakamaModule, code string) error {
+	return nk.StorageDelete(ctx, []*runtime.StorageDelete{{Collection: joinCodeCollection, Key: code}})
+}
+
+// lookupJoinCode resolves code to the ID of the room holding it, or "" if there is none.
+// Codes whose room ended without releasing them, say in a crash, are released here.
+func lookupJoinCode(ctx context.Context, nk runtime.NakamaModule, code string) (string, error) {
+	objects, err := nk.StorageRead(ctx, []*runtime.StorageRead{{Collection: joinCodeCollection, Key: code}})
+	if err != nil || len(objects) == 0 {
+		return "", err
+	}
+	var entry joinCodeEntry
+	if err := json.Unmarshal([]byte(objects[0].GetValue()), &entry); err != nil {
+		return "", fmt.Errorf("corrupt join code %s: %w", code, err)
+	}
+	if entry.MatchID == "" {
+		return "", nil // Reserved by a room still being created
+	}
+	m, err := nk.MatchGet(ctx, entry.MatchID)
+	if err != nil {
+		return "", err
+	}
+	if m == nil {
+		return "", releaseJoinCode(ctx, nk, code)
+	}
+	return entry.MatchID, nil
+}
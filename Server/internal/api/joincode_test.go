@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/runtime"
+)
+
+// fakeNakama keeps storage objects and matches in memory; every other call panics on the
+// nil module. Writes with version "*" fail when the key is taken, as in Nakama.
+type fakeNakama struct {
+	runtime.NakamaModule
+	objects   map[string]string // Key to value, all in joinCodeCollection
+	matches   map[string]map[string]interface{}
+	clashes   int   // Number of upcoming "*" writes to fail as if the code were taken
+	createErr error // Returned by MatchCreate when set
+}
+
+func newFakeNakama() *fakeNakama {
+	return &fakeNakama{objects: make(map[string]string), matches: make(map[string]map[string]interface{})}
+}
+
+func (n *fakeNakama) StorageWrite(ctx context.Context, writes []*runtime.StorageWrite) ([]*api.StorageObjectAck, error) {
+	for _, w := range writes {
+		if w.Version == "*" {
+			if _, taken := n.objects[w.Key]; taken || n.clashes > 0 {
+				n.clashes--
+				return nil, errors.New("storage write rejected - version check failed")
+			}
+		}
+		n.objects[w.Key] = w.Value
+	}
+	return nil, nil
+}
+
+func (n *fakeNakama) StorageRead(ctx context.Context, reads []*runtime.StorageRead) ([]*api.StorageObject, error) {
+	var objects []*api.StorageObject
+	for _, r := range reads {
+		if value, ok := n.objects[r.Key]; ok {
+			objects = append(objects, &api.StorageObject{Collection: r.Collection, Key: r.Key, Value: value})
+		}
+	}
+	return objects, nil
+}
+
+func (n *fakeNakama) StorageDelete(ctx context.Context, deletes []*runtime.StorageDelete) error {
+	for _, d := range deletes {
+		delete(n.objects, d.Key)
+	}
+	return nil
+}
+
+func (n *fakeNakama) MatchCreate(ctx context.Context, module string, params map[string]interface{}) (string, error) {
+	if n.createErr != nil {
+		return "", n.createErr
+	}
+	id := "match-" + string(rune('a'+len(n.matches)))
+	n.matches[id] = params
+	return id, nil
+}
+
+func (n *fakeNakama) MatchGet(ctx context.Context, id string) (*api.Match, error) {
+	if _, ok := n.matches[id]; !ok {
+		return nil, nil
+	}
+	return &api.Match{MatchId: id}, nil
+}
+
+type testLogger struct{}
+
+func (testLogger) Debug(format string, v ...interface{})                     {}
+func (testLogger) Info(format string, v ...interface{})                      {}
+func (testLogger) Warn(format string, v ...interface{})                      {}
+func (testLogger) Error(format string, v ...interface{})                     {}
+func (l testLogger) WithField(key string, v interface{}) runtime.Logger      { return l }
+func (l testLogger) WithFields(fields map[string]interface{}) runtime.Logger { return l }
+func (testLogger) Fields() map[string]interface{}                            { return nil }
+
+func TestNewJoinCodeUsesAlphabet(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		code, err := newJoinCode()
+		if err != nil {
+			t.Fatalf("failed to draw a join code: %v", err)
+		}
+		if len(code) != joinCodeLength || strings.Trim(code, joinCodeAlphabet) != "" {
+			t.Fatalf("expected %d characters from %q, got %q", joinCodeLength, joinCodeAlphabet, code)
+		}
+	}
+	if got := normalizeJoinCode("  k7qm2x "); got != "K7QM2X" {
+		t.Fatalf("expected the code as typed to normalize to K7QM2X, got %q", got)
+	}
+}
+
+func TestReserveJoinCodeRetriesClashes(t *testing.T) {
+	ctx := context.Background()
+	nk := newFakeNakama()
+	nk.clashes = joinCodeAttempts - 1
+	code, err := reserveJoinCode(ctx, nk)
+	if err != nil {
+		t.Fatalf("expected a code on the last attempt, got %v", err)
+	}
+	if value, ok := nk.objects[code]; !ok || value != `{"match_id":""}` {
+		t.Fatalf("expected %s to be reserved without a room yet, got %q", code, value)
+	}
+
+	nk.clashes = joinCodeAttempts
+	if _, err := reserveJoinCode(ctx, nk); err == nil {
+		t.Fatalf("expected to give up after %d clashes", joinCodeAttempts)
+	}
+}
+
+func TestLookupJoinCode(t *testing.T) {
+	ctx := context.Background()
+	nk := newFakeNakama()
+	nk.matches["match-a"] = nil
+	nk.objects["K7QM2X"] = `{"match_id":"match-a"}`
+	nk.objects["ABCDEF"] = `{"match_id":""}`
+	nk.objects["GHJKLM"] = `{"match_id":"match-gone"}`
+
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"K7QM2X", "match-a"},
+		{"ABCDEF", ""}, // Reserved by a room still being created
+		{"GHJKLM", ""}, // Its room ended without releasing it
+		{"NPQRST", ""},
+	}
+	for _, tt := range tests {
+		got, err := lookupJoinCode(ctx, nk, tt.code)
+		if err != nil || got != tt.want {
+			t.Fatalf("code %s: expected %q, got %q, %v", tt.code, tt.want, got, err)
+		}
+	}
+	if _, ok := nk.objects["GHJKLM"]; ok {
+		t.Fatalf("expected the code of the ended room to be released")
+	}
+	if _, ok := nk.objects["ABCDEF"]; !ok {
+		t.Fatalf("expected the reserved code to stay taken")
+	}
+}
+
+func TestRpcCreateMatchPrivateRoomJoinsByCode(t *testing.T) {
+	ctx := context.Background()
+	nk := newFakeNakama()
+
+	out, err := RpcCreateMatch(ctx, testLogger{}, nil, nk, `{"private":true}`)
+	if err != nil {
+		t.Fatalf("failed to create a private room: %v", err)
+	}
+	var created map[string]string
+	if err := json.Unmarshal([]byte(out), &created); err != nil || created["match_id"] == "" || created["join_code"] == "" {
+		t.Fatalf("expected a match ID and a join code, got %s", out)
+	}
+	if got := nk.matches[created["match_id"]]["join_code"]; got != created["join_code"] {
+		t.Fatalf("expected the room to be created with its join code, got %v", got)
+	}
+
+	// Players type the code in however they like.
+	payload := `{"code":" ` + strings.ToLower(created["join_code"]) + `"}`
+	out, err = RpcJoinByCode(ctx, testLogger{}, nil, nk, payload)
+	if err != nil {
+		t.Fatalf("failed to join by code: %v", err)
+	}
+	var joined map[string]string
+	if err := json.Unmarshal([]byte(out), &joined); err != nil || joined["match_id"] != created["match_id"] {
+		t.Fatalf("expected the code to lead to %s, got %s", created["match_id"], out)
+	}
+
+	// Public rooms get no code.
+	out, err = RpcCreateMatch(ctx, testLogger{}, nil, nk, "")
+	if err != nil || strings.Contains(out, "join_code") {
+		t.Fatalf("expected a public room without a join code, got %s, %v", out, err)
+	}
+}
+
+func TestRpcJoinByCodeRejectsUnknownCodes(t *testing.T) {
+	ctx := context.Background()
+	nk := newFakeNakama()
+
+	tests := []struct {
+		payload string
+		code    int
+	}{
+		{"", 3},
+		{`{"code":""}`, 3},
+		{`{"code":"K7QM2X"}`, 5},
+	}
+	for _, tt := range tests {
+		_, err := RpcJoinByCode(ctx, testLogger{}, nil, nk, tt.payload)
+		var rpcErr *runtime.Error
+		if !errors.As(err, &rpcErr) || rpcErr.Code != tt.code {
+			t.Fatalf("payload %q: expected an error with code %d, got %v", tt.payload, tt.code, err)
+		}
+	}
+}
+
+func TestRpcCreateMatchReleasesCodeOnFailure(t *testing.T) {
+	ctx := context.Background()
+	nk := newFakeNakama()
+
+	if _, err := RpcCreateMatch(ctx, testLogger{}, nil, nk, `{"private":true,"join_code":"K7QM2X"}`); err == nil {
+		t.Fatalf("expected a join code chosen by the client to be rejected")
+	}
+
+	nk.createErr = errors.New("match create failed")
+	if _, err := RpcCreateMatch(ctx, testLogger{}, nil, nk, `{"private":true}`); err == nil {
+		t.Fatalf("expected the failed match creation to be reported")
+	}
+	if len(nk.objects) != 0 {
+		t.Fatalf("expected the reserved code to be released, got %v", nk.objects)
+	}
+}
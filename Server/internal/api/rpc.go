@@ -8,17 +8,58 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/yourusername/tienlen-server/internal/match"
+	"github.com/yourusername/tienlen-server/internal/tienlen"
 )
 
 // RpcCreateMatch creates a new authoritative match and returns the match ID.
+// An optional JSON payload (e.g. {"variant": "northern", "seats": 6}) sets up the room; see
+// match.RoomConfig for the fields. Settings left out keep their defaults, and an invalid
+// config is rejected with the reason. A private room also gets a join code, returned as
+// "join_code", which players pass to join_by_code to find it.
 func RpcCreateMatch(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
-	matchID, err := nk.MatchCreate(ctx, "tienlen_match", nil)
+	config, err := match.ParseRoomConfig(payload)
+	if err != nil {
+		logger.Error("Error parsing create_match payload: %v", err)
+		return "", runtime.NewError(err.Error(), 3) // INVALID_ARGUMENT
+	}
+	if config.JoinCode != "" {
+		return "", runtime.NewError("join_code is assigned by the server", 3) // INVALID_ARGUMENT
+	}
+	if config.Private {
+		if config.JoinCode, err = reserveJoinCode(ctx, nk); err != nil {
+			logger.Error("Error reserving join code: %v", err)
+			return "", err
+		}
+	}
+
+	// A reserved code is released again if the room does not come about.
+	release := func() {
+		if config.JoinCode == "" {
+			return
+		}
+		if err := releaseJoinCode(ctx, nk, config.JoinCode); err != nil {
+			logger.Error("Error releasing join code %s: %v", config.JoinCode, err)
+		}
+	}
+	matchID, err := nk.MatchCreate(ctx, "tienlen_match", config.Params())
 	if err != nil {
 		logger.Error("Error creating match: %v", err)
+		release()
 		return "", err
 	}
+	if config.JoinCode != "" {
+		if err := writeJoinCode(ctx, nk, config.JoinCode, matchID, ""); err != nil {
+			logger.Error("Error saving join code %s: %v", config.JoinCode, err)
+			release()
+			return "", err
+		}
+	}
 
 	response := map[string]string{"match_id": matchID}
+	if config.JoinCode != "" {
+		response["join_code"] = config.JoinCode
+	}
 	bytes, err := json.Marshal(response)
 	if err != nil {
 		logger.Error("Error marshalling response: %v", err)
@@ -28,30 +69,105 @@ func RpcCreateMatch(ctx context.Context, logger runtime.Logger, db *sql.DB, nk r
 	return string(bytes), nil
 }
 
-// RpcQuickMatch searches for an available match or creates a new one.
+// RpcJoinByCode resolves the join code of a private room, given as {"code": "K7QM2X"}, to its
+// match ID. The client joins with the code as the "join_code" join metadata, along with the
+// room's password, if any, as "password".
+func RpcJoinByCode(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+	var request struct {
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal([]byte(payload), &request); err != nil || request.Code == "" {
+		return "", runtime.NewError("join_by_code needs a code", 3) // INVALID_ARGUMENT
+	}
+	code := normalizeJoinCode(request.Code)
+
+	matchID, err := lookupJoinCode(ctx, nk, code)
+	if err != nil {
+		logger.Error("Error looking up join code %s: %v", code, err)
+		return "", err
+	}
+	if matchID == "" {
+		return "", runtime.NewError("No room with that code", 5) // NOT_FOUND
+	}
+
+	response := map[string]string{"match_id": matchID}
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		logger.Error("Error marshalling response: %v", err)
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// quickMatchCandidates is how many open tables quick match considers.
+const quickMatchCandidates = 20
+
+// RpcQuickMatch finds the best open table that has not started its game, or creates a new
+// one. An optional payload such as {"variant": "northern", "stake_tier": "low"} narrows the
+// search, and a table created for it plays that variant for a stake in that tier. Without a
+// stake_tier only free tables are offered. Private and password-protected tables never are.
 func RpcQuickMatch(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
-	// Search for an available match
-	// MatchList(ctx, limit, authoritative, label, minSize, maxSize, query)
-	limit := 1
-	authoritative := true
-	label := "TienLen"
-	minSize := 0
-	maxSize := 3 // We want matches with at most 3 players so there is room for one more
-
-	matches, err := nk.MatchList(ctx, limit, authoritative, label, &minSize, &maxSize, "")
+	var request struct {
+		Variant   string `json:"variant"`
+		StakeTier string `json:"stake_tier"`
+	}
+	if payload != "" {
+		if err := json.Unmarshal([]byte(payload), &request); err != nil {
+			return "", runtime.NewError("invalid quick_match payload", 3) // INVALID_ARGUMENT
+		}
+	}
+	config := match.DefaultRoomConfig()
+	if request.Variant != "" {
+		config.Variant = tienlen.Variant(request.Variant)
+	}
+	if err := config.Validate(); err != nil {
+		return "", runtime.NewError(err.Error(), 3) // INVALID_ARGUMENT
+	}
+	// Players who did not pick a tier must not be seated at a table playing for chips.
+	if request.StakeTier == "" {
+		request.StakeTier = match.StakeFree
+	}
+	switch request.StakeTier {
+	case match.StakeFree, match.StakeLow, match.StakeMid, match.StakeHigh:
+		config.Stake = match.TierStake(request.StakeTier)
+	default:
+		return "", runtime.NewError(fmt.Sprintf("unknown stake_tier %q", request.StakeTier), 3) // INVALID_ARGUMENT
+	}
+
+	// Tables between games take newcomers for the next deal; private and locked ones are
+	// left out of the query so they do not crowd out the tables quick match may offer.
+	query := "+label.open_seats:>=1 -label.phase:playing -label.phase:settlement -label.private:T -label.password:T"
+	if request.Variant != "" {
+		query += " +label.variant:" + request.Variant
+	}
+	query += " +label.stake_tier:" + request.StakeTier
+	matches, err := nk.MatchList(ctx, quickMatchCandidates, true, "", nil, nil, query)
 	if err != nil {
 		logger.Error("Error listing matches: %v", err)
 		return "", err
 	}
 
+	// The fullest table gets its game going soonest.
 	var matchID string
-	if len(matches) > 0 {
-		// Found an available match
-		matchID = matches[0].GetMatchId()
+	best := -1
+	for _, m := range matches {
+		var label match.Label
+		if err := json.Unmarshal([]byte(m.GetLabel().GetValue()), &label); err != nil {
+			continue
+		}
+		if label.Private || label.Password {
+			continue
+		}
+		if best == -1 || label.OpenSeats < best {
+			matchID, best = m.GetMatchId(), label.OpenSeats
+		}
+	}
+
+	if matchID != "" {
 		logger.Info("Found existing match: %s", matchID)
 	} else {
-		// No available match, create a new one
-		matchID, err = nk.MatchCreate(ctx, "tienlen_match", nil)
+		matchID, err = nk.MatchCreate(ctx, "tienlen_match", config.Params())
 		if err != nil {
 			logger.Error("Error creating new match: %v", err)
 			return "", err
@@ -0,0 +1,189 @@
+// Package bot implements computer players that can fill empty seats at a table.
+package bot
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+)
+
+// Strategy names, as chosen by the room owner when seating a bot.
+const (
+	StrategyGreedy = "greedy"
+	StrategyRandom = "random"
+)
+
+// View is everything a bot may look at when deciding: its own hand and the public table state.
+type View struct {
+	PlayerID  string
+	Hand      []tienlen.Card
+	Board     []tienlen.Card // Empty when the bot leads the round
+	HandSizes map[string]int // Cards left per player still in the game
+	Rules     tienlen.RuleSet
+
+	// OpeningCard is set when the play must include it (the first play at a small table).
+	OpeningCard *tienlen.Card
+}
+
+// Leading reports whether the bot opens the round, in which case it may not pass.
+func (v View) Leading() bool {
+	return len(v.Board) == 0
+}
+
+// Decision is a bot's move: play Cards, or pass when Pass is set.
+type Decision struct {
+	Pass  bool
+	Cards []tienlen.Card
+}
+
+// Bot chooses a move for its seat. Decisions go through the same Game.PlayCards and
+// Game.Pass validation as human moves, so a bot cannot cheat.
+type Bot interface {
+	Decide(view View) Decision
+}
+
+// New returns a bot playing the given strategy. An empty strategy selects greedy.
+func New(strategy string, rng *rand.Rand) (Bot, error) {
+	switch strategy {
+	case StrategyGreedy, "":
+		return Greedy{}, nil
+	case StrategyRandom:
+		return NewRandom(rng), nil
+	default:
+		return nil, fmt.Errorf("unknown bot strategy %q", strategy)
+	}
+}
+
+// ViewOf builds the view of the game seen by playerID.
+func ViewOf(g *tienlen.Game, playerID string) View {
+	sizes := make(map[string]int, len(g.TurnOrder))
+	for _, uid := range g.TurnOrder {
+		if !g.FinishedPlayers[uid] {
+			sizes[uid] = len(g.Hands[uid])
+		}
+	}
+	view := View{
+		PlayerID:  playerID,
+		Hand:      g.HandOf(playerID),
+		Board:     append([]tienlen.Card(nil), g.Board...),
+		HandSizes: sizes,
+		Rules:     g.Rules(),
+	}
+	if c, ok := g.OpeningCard(); ok {
+		view.OpeningCard = &c
+	}
+	return view
+}
+
+// hints ranks the bot's legal moves, keeping only those allowed by the opening card rule.
+func (v View) hints() []tienlen.Hint {
+	hints := tienlen.RankHints(v.Hand, v.Board, v.Rules)
+	if v.OpeningCard != nil {
+		hints = tienlen.HintsWith(hints, *v.OpeningCard)
+	}
+	return hints
+}
+
+// Act lets b decide for playerID and applies the decision to the game through the same
+// PlayCards and Pass path as human moves. Should the game reject a play, the bot passes
+// instead when allowed.
+func Act(g *tienlen.Game, b Bot, playerID string) ([]tienlen.Event, error) {
+	view := ViewOf(g, playerID)
+	decision := b.Decide(view)
+	if decision.Pass {
+		return g.Pass(playerID)
+	}
+	events, err := g.PlayCardValues(playerID, decision.Cards)
+	if err != nil && !view.Leading() {
+		return g.Pass(playerID)
+	}
+	return events, err
+}
+
+// Greedy plays the cheapest sensible move, as ranked by tienlen.RankHints. While following
+// it keeps 2s until an opponent is close to going out, and only spends bombs on a chop.
+type Greedy struct{}
+
+// dangerHandSize is the opponent hand size at which Greedy stops holding cards back.
+const dangerHandSize = 3
+
+func (Greedy) Decide(v View) Decision {
+	hints := v.hints()
+	if len(hints) == 0 {
+		return fallback(v)
+	}
+	best := hints[0].Cards
+	if v.Leading() || len(best) == len(v.Hand) {
+		return Decision{Cards: best}
+	}
+
+	board := tienlen.Classify(v.Board)
+	if board.IsTwos() || board.IsBomb() {
+		for _, h := range hints {
+			if tienlen.Classify(h.Cards).IsBomb() { // A chop collects the penalty
+				return Decision{Cards: h.Cards}
+			}
+		}
+	}
+
+	combo := tienlen.Classify(best)
+	switch {
+	case combo.IsBomb() && !board.IsTwos() && !board.IsBomb():
+		return Decision{Pass: true}
+	case combo.IsTwos() && !opponentClose(v):
+		return Decision{Pass: true}
+	}
+	return Decision{Cards: best}
+}
+
+func opponentClose(v View) bool {
+	for uid, n := range v.HandSizes {
+		if uid != v.PlayerID && n <= dangerHandSize {
+			return true
+		}
+	}
+	return false
+}
+
+// Random picks uniformly among the legal moves, counting a pass as one more option when following.
+type Random struct {
+	rng *rand.Rand
+}
+
+// NewRandom returns a random bot drawing from rng, or from a randomly seeded source when rng is nil.
+func NewRandom(rng *rand.Rand) *Random {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+	return &Random{rng: rng}
+}
+
+func (r *Random) Decide(v View) Decision {
+	hints := v.hints()
+	moves := make([][]tienlen.Card, len(hints))
+	for i, h := range hints {
+		moves[i] = h.Cards
+	}
+	options := len(moves)
+	if !v.Leading() {
+		options++
+	}
+	if options == 0 {
+		return fallback(v)
+	}
+	pick := r.rng.Intn(options)
+	if pick == len(moves) {
+		return Decision{Pass: true}
+	}
+	return Decision{Cards: moves[pick]}
+}
+
+// fallback is used when no combination is playable: pass if allowed, otherwise lead the lowest
+// card, which is also the opening card when there is one.
+func fallback(v View) Decision {
+	if !v.Leading() || len(v.Hand) == 0 {
+		return Decision{Pass: true}
+	}
+	return Decision{Cards: v.Hand[:1]}
+}
@@ -0,0 +1,135 @@
+package bot
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+)
+
+func TestNew(t *testing.T) {
+	for _, strategy := range []string{"", StrategyGreedy, StrategyRandom} {
+		if _, err := New(strategy, nil); err != nil {
+			t.Fatalf("New(%q) returned error: %v", strategy, err)
+		}
+	}
+	if _, err := New("genius", nil); err == nil {
+		t.Fatalf("expected an error for an unknown strategy")
+	}
+}
+
+func TestGreedyDecide(t *testing.T) {
+	rules := tienlen.SouthernRules{}
+	hand := []tienlen.Card{
+		{Rank: 0, Suit: 0},                     // 3♠
+		{Rank: 5, Suit: 1},                     // 8♣
+		{Rank: 12, Suit: 3},                    // 2♥
+		{Rank: 9, Suit: 0}, {Rank: 9, Suit: 1}, // Pair of Qs
+		{Rank: 9, Suit: 2}, {Rank: 9, Suit: 3}, // ...making a quad
+	}
+	tienlen.SortHand(hand)
+
+	tests := []struct {
+		name      string
+		board     []tienlen.Card
+		handSizes map[string]int
+		wantPass  bool
+		wantCards []tienlen.Card
+	}{
+		{
+			name:      "leads its cheapest card",
+			board:     nil,
+			handSizes: map[string]int{"opp": 13},
+			wantCards: []tienlen.Card{{Rank: 0, Suit: 0}},
+		},
+		{
+			name:      "follows with the cheapest single",
+			board:     []tienlen.Card{{Rank: 2, Suit: 0}},
+			handSizes: map[string]int{"opp": 13},
+			wantCards: []tienlen.Card{{Rank: 5, Suit: 1}},
+		},
+		{
+			name:      "holds its 2 while opponents have many cards",
+			board:     []tienlen.Card{{Rank: 11, Suit: 3}},
+			handSizes: map[string]int{"opp": 13},
+			wantPass:  true,
+		},
+		{
+			name:      "plays its 2 when an opponent is about to go out",
+			board:     []tienlen.Card{{Rank: 11, Suit: 3}},
+			handSizes: map[string]int{"opp": 2},
+			wantCards: []tienlen.Card{{Rank: 12, Suit: 3}},
+		},
+		{
+			name:      "chops a 2 with its quad",
+			board:     []tienlen.Card{{Rank: 12, Suit: 2}},
+			handSizes: map[string]int{"opp": 13},
+			wantCards: []tienlen.Card{{Rank: 9, Suit: 0}, {Rank: 9, Suit: 1}, {Rank: 9, Suit: 2}, {Rank: 9, Suit: 3}},
+		},
+		{
+			name:      "passes when nothing beats the board",
+			board:     []tienlen.Card{{Rank: 1, Suit: 0}, {Rank: 2, Suit: 0}, {Rank: 3, Suit: 0}},
+			handSizes: map[string]int{"opp": 13},
+			wantPass:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Greedy{}.Decide(View{PlayerID: "me", Hand: hand, Board: tt.board, HandSizes: tt.handSizes, Rules: rules})
+			if d.Pass != tt.wantPass {
+				t.Fatalf("expected pass=%v, got %+v", tt.wantPass, d)
+			}
+			if !tt.wantPass && !sameCards(d.Cards, tt.wantCards) {
+				t.Fatalf("expected %v, got %v", tt.wantCards, d.Cards)
+			}
+		})
+	}
+}
+
+func TestRandomAlwaysLegal(t *testing.T) {
+	rules := tienlen.SouthernRules{}
+	rng := rand.New(rand.NewSource(7))
+	r := NewRandom(rng)
+
+	for i := 0; i < 200; i++ {
+		deck := tienlen.NewDeck()
+		rng.Shuffle(len(deck), func(a, b int) { deck[a], deck[b] = deck[b], deck[a] })
+		hand := deck[:13]
+		tienlen.SortHand(hand)
+		var board []tienlen.Card
+		if i%2 == 1 {
+			board = deck[13:14]
+		}
+
+		d := r.Decide(View{PlayerID: "me", Hand: hand, Board: board, Rules: rules})
+		if d.Pass {
+			if board == nil {
+				t.Fatalf("random bot passed while leading")
+			}
+			continue
+		}
+		if !rules.IsValidSet(d.Cards) {
+			t.Fatalf("random bot chose invalid set %v", d.Cards)
+		}
+		if board != nil && !rules.CanBeat(board, d.Cards) {
+			t.Fatalf("random bot chose %v which does not beat %v", d.Cards, board)
+		}
+	}
+}
+
+func sameCards(a, b []tienlen.Card) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]tienlen.Card(nil), a...)
+	b = append([]tienlen.Card(nil), b...)
+	tienlen.SortHand(a)
+	tienlen.SortHand(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -7,18 +7,33 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// TurnClock is the state of the turn timer sent with turn updates. The zero value means
+// the room has no turn clock.
+type TurnClock struct {
+	SecondsRemaining int
+	Deadline         int64            // Unix milliseconds
+	TimeBanks        map[string]int64 // Milliseconds left per player; nil without a time bank
+}
+
 // DispatchEvents converts domain events into protobuf messages and broadcasts them.
-func DispatchEvents(dispatcher runtime.MatchDispatcher, presences map[string]runtime.Presence, events []tienlen.Event) {
+// Turn updates carry clock, the timer of the turn the events lead to.
+func DispatchEvents(dispatcher runtime.MatchDispatcher, presences map[string]runtime.Presence, events []tienlen.Event, clock TurnClock) {
 	for _, ev := range events {
 		switch e := ev.(type) {
 		case tienlen.GameStarted:
 			sendGameStarted(dispatcher, presences, e)
 		case tienlen.HandUpdated:
 			sendHandUpdate(dispatcher, presences, e)
+		case tienlen.TurnTimedOut:
+			sendTurnTimedOut(dispatcher, e)
 		case tienlen.TurnChanged:
-			sendTurnUpdate(dispatcher, e)
+			sendTurnUpdate(dispatcher, e, clock)
 		case tienlen.RoundEnded:
 			sendRoundEnd(dispatcher, e)
+		case tienlen.InstantWin:
+			sendInstantWin(dispatcher, e)
+		case tienlen.ChopOccurred:
+			sendChop(dispatcher, e)
 		case tienlen.GameOver:
 			sendGameOver(dispatcher, e)
 		}
@@ -31,16 +46,27 @@ func BroadcastOwnerUpdate(dispatcher runtime.MatchDispatcher, ownerID string) {
 	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_OWNER_UPDATE), data, nil, nil, true)
 }
 
+// Table is the match-level state sent alongside the game snapshot: seating and ownership.
+type Table struct {
+	Seats   []string // seat index -> userID (empty string means free)
+	OwnerID string
+	BotIDs  []string // Seated players controlled by the server
+
+	TurnDeadline int64            // Unix milliseconds at which the active turn times out; 0 without a turn clock
+	TimeBanks    map[string]int64 // Milliseconds left per player; nil without a time bank
+	Disconnected []string         // Seated players whose seat and hand are held until they reconnect
+
+	Phase         string   // Lifecycle phase of the match
+	PhaseDeadline int64    // Unix milliseconds at which a timed phase ends; 0 otherwise
+	Ready         []string // Seated players ready for the next game
+
+	NextSeedHash string         // Hash of the server seed of the next deal
+	Config       *pb.RoomConfig // Settings the room was created with
+}
+
 // SendMatchState synchronizes a late joiner with the current match state.
-func SendMatchState(dispatcher runtime.MatchDispatcher, snapshot tienlen.Snapshot, seats []string, receiver runtime.Presence) {
-	packet := &pb.MatchStatePacket{
-		IsPlaying:      snapshot.IsPlaying,
-		OwnerId:        snapshot.OwnerID,
-		Board:          toPBCards(snapshot.Board),
-		ActivePlayerId: snapshot.ActivePlayerID,
-		PlayerIds:      seats,
-	}
-	data, err := proto.Marshal(packet)
+func SendMatchState(dispatcher runtime.MatchDispatcher, snapshot tienlen.Snapshot, table Table, receiver runtime.Presence) {
+	data, err := proto.Marshal(matchStatePacket(snapshot, table))
 	if err != nil {
 		return
 	}
@@ -48,9 +74,10 @@ func SendMatchState(dispatcher runtime.MatchDispatcher, snapshot tienlen.Snapsho
 }
 
 // SendHand sends a targeted hand update to a specific player.
-func SendHand(dispatcher runtime.MatchDispatcher, userID string, cards []tienlen.Card, receivers []runtime.Presence) {
+func SendHand(dispatcher runtime.MatchDispatcher, userID string, cards []tienlen.Card, version int, receivers []runtime.Presence) {
 	packet := &pb.HandUpdatePacket{
-		Hand: toPBCards(cards),
+		Hand:    toPBCards(cards),
+		Version: int32(version),
 	}
 	data, err := proto.Marshal(packet)
 	if err != nil {
@@ -59,6 +86,23 @@ func SendHand(dispatcher runtime.MatchDispatcher, userID string, cards []tienlen
 	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_HAND_UPDATE), data, receivers, nil, true)
 }
 
+// SendHints answers a hint request with the suggested plays.
+func SendHints(dispatcher runtime.MatchDispatcher, hints []tienlen.Hint, canPass bool, receiver runtime.Presence) {
+	packet := &pb.HintPacket{CanPass: canPass}
+	for _, h := range hints {
+		indices := make([]int32, 0, len(h.Indices))
+		for _, idx := range h.Indices {
+			indices = append(indices, int32(idx))
+		}
+		packet.Moves = append(packet.Moves, &pb.HintMove{CardIndices: indices, Cards: toPBCards(h.Cards)})
+	}
+	data, err := proto.Marshal(packet)
+	if err != nil {
+		return
+	}
+	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_HINT), data, []runtime.Presence{receiver}, nil, true)
+}
+
 func sendGameStarted(dispatcher runtime.MatchDispatcher, presences map[string]runtime.Presence, ev tienlen.GameStarted) {
 	for playerID, hand := range ev.Hands {
 		presence, ok := presences[playerID]
@@ -66,9 +110,15 @@ func sendGameStarted(dispatcher runtime.MatchDispatcher, presences map[string]ru
 			continue
 		}
 		packet := &pb.MatchStartPacket{
-			Hand:      toPBCards(hand),
-			PlayerIds: ev.TurnOrder, // TurnOrder now matches seat order
-			OwnerId:   ev.OwnerID,
+			Hand:           toPBCards(hand),
+			PlayerIds:      ev.Players,
+			TurnOrder:      ev.TurnOrder,
+			OwnerId:        ev.OwnerID,
+			DealCommitment: ev.Commitment,
+			ClientEntropy:  ev.ClientEntropy,
+			HandVersion:    tienlen.InitialHandVersion,
+			HandSize:       int32(ev.HandSize),
+			OpeningCard:    toPBCard(ev.OpeningCard),
 		}
 		data, err := proto.Marshal(packet)
 		if err != nil {
@@ -83,14 +133,25 @@ func sendHandUpdate(dispatcher runtime.MatchDispatcher, presences map[string]run
 	if !ok {
 		return
 	}
-	SendHand(dispatcher, ev.PlayerID, ev.Hand, []runtime.Presence{presence})
+	SendHand(dispatcher, ev.PlayerID, ev.Hand, ev.Version, []runtime.Presence{presence})
 }
 
-func sendTurnUpdate(dispatcher runtime.MatchDispatcher, ev tienlen.TurnChanged) {
+func sendTurnTimedOut(dispatcher runtime.MatchDispatcher, ev tienlen.TurnTimedOut) {
+	packet := &pb.TurnTimedOutPacket{PlayerId: ev.PlayerID, Cards: toPBCards(ev.Cards)}
+	data, err := proto.Marshal(packet)
+	if err != nil {
+		return
+	}
+	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_TURN_TIMED_OUT), data, nil, nil, true)
+}
+
+func sendTurnUpdate(dispatcher runtime.MatchDispatcher, ev tienlen.TurnChanged, clock TurnClock) {
 	packet := &pb.TurnUpdatePacket{
 		ActivePlayerId:   ev.ActivePlayerID,
 		LastPlayedCards:  toPBCards(ev.Board),
-		SecondsRemaining: 30,
+		SecondsRemaining: int32(clock.SecondsRemaining),
+		DeadlineUnixMs:   clock.Deadline,
+		TimeBankMs:       clock.TimeBanks,
 	}
 	data, err := proto.Marshal(packet)
 	if err != nil {
@@ -99,59 +160,117 @@ func sendTurnUpdate(dispatcher runtime.MatchDispatcher, ev tienlen.TurnChanged)
 	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_TURN_UPDATE), data, nil, nil, true)
 }
 
-// BroadcastSeatUpdate sends the current seat map to all players.
-func BroadcastPlayerJoined(dispatcher runtime.MatchDispatcher, seats []string, ownerID string, game *tienlen.Game) {
+// BroadcastPlayerJoined sends the current seat map to all players after someone (or a bot) takes a seat.
+func BroadcastPlayerJoined(dispatcher runtime.MatchDispatcher, table Table, game *tienlen.Game) {
+	broadcastMatchState(dispatcher, table, game)
+}
+
+// BroadcastPlayerLeft updates everyone with the current state after one or more players leave.
+func BroadcastPlayerLeft(dispatcher runtime.MatchDispatcher, table Table, game *tienlen.Game) {
+	broadcastMatchState(dispatcher, table, game)
+}
+
+// BroadcastPhaseChanged updates everyone with the current state when the match moves to
+// another phase or a player gets ready.
+func BroadcastPhaseChanged(dispatcher runtime.MatchDispatcher, table Table, game *tienlen.Game) {
+	broadcastMatchState(dispatcher, table, game)
+}
+
+func broadcastMatchState(dispatcher runtime.MatchDispatcher, table Table, game *tienlen.Game) {
 	snapshot := tienlen.Snapshot{}
 	if game != nil {
 		snapshot = game.Snapshot()
 	}
+	data, err := proto.Marshal(matchStatePacket(snapshot, table))
+	if err != nil {
+		return
+	}
+	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_MATCH_STATE), data, nil, nil, true)
+}
 
-	packet := &pb.MatchStatePacket{
+func matchStatePacket(snapshot tienlen.Snapshot, table Table) *pb.MatchStatePacket {
+	return &pb.MatchStatePacket{
 		IsPlaying:      snapshot.IsPlaying,
-		OwnerId:        ownerID,
+		OwnerId:        table.OwnerID,
 		Board:          toPBCards(snapshot.Board),
 		ActivePlayerId: snapshot.ActivePlayerID,
-		PlayerIds:      seats,
+		PlayerIds:      table.Seats,
+		Variant:        string(snapshot.Variant),
+		BotIds:         table.BotIDs,
+		NextSeedHash:   table.NextSeedHash,
+		PlayerCount:    int32(snapshot.PlayerCount),
+		HandSize:       int32(snapshot.HandSize),
+		OpeningCard:    toPBCard(snapshot.OpeningCard),
+		SeatCount:      int32(len(table.Seats)),
+		Direction:      string(snapshot.Direction),
+
+		TurnDeadlineUnixMs: table.TurnDeadline,
+		TimeBankMs:         table.TimeBanks,
+		DisconnectedIds:    table.Disconnected,
+
+		Phase:               table.Phase,
+		PhaseDeadlineUnixMs: table.PhaseDeadline,
+		ReadyIds:            table.Ready,
+		Config:              table.Config,
 	}
+}
+
+func sendRoundEnd(dispatcher runtime.MatchDispatcher, ev tienlen.RoundEnded) {
+	packet := &pb.RoundEndPacket{WinnerId: ev.WinnerID}
 	data, err := proto.Marshal(packet)
 	if err != nil {
 		return
 	}
-	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_MATCH_STATE), data, nil, nil, true)
+	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_ROUND_END), data, nil, nil, true)
 }
 
-// BroadcastPlayerLeft updates everyone with the current state after one or more players leave.
-func BroadcastPlayerLeft(dispatcher runtime.MatchDispatcher, seats []string, ownerID string, game *tienlen.Game) {
-	snapshot := tienlen.Snapshot{}
-	if game != nil {
-		snapshot = game.Snapshot()
-	}
-
-	packet := &pb.MatchStatePacket{
-		IsPlaying:      snapshot.IsPlaying,
-		OwnerId:        ownerID,
-		Board:          toPBCards(snapshot.Board),
-		ActivePlayerId: snapshot.ActivePlayerID,
-		PlayerIds:      seats,
+func sendInstantWin(dispatcher runtime.MatchDispatcher, ev tienlen.InstantWin) {
+	packet := &pb.InstantWinPacket{
+		PlayerId: ev.PlayerID,
+		Pattern:  string(ev.Pattern),
+		Hand:     toPBCards(ev.Hand),
 	}
 	data, err := proto.Marshal(packet)
 	if err != nil {
 		return
 	}
-	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_MATCH_STATE), data, nil, nil, true)
+	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_INSTANT_WIN), data, nil, nil, true)
 }
 
-func sendRoundEnd(dispatcher runtime.MatchDispatcher, ev tienlen.RoundEnded) {
-	packet := &pb.RoundEndPacket{WinnerId: ev.WinnerID}
+func sendChop(dispatcher runtime.MatchDispatcher, ev tienlen.ChopOccurred) {
+	packet := &pb.ChopPacket{
+		ChopperId:    ev.ChopperID,
+		VictimId:     ev.VictimID,
+		ChoppedCards: toPBCards(ev.Chopped),
+		BombCards:    toPBCards(ev.Bomb),
+		Penalty:      int32(ev.Penalty),
+		Chain:        int32(ev.Chain),
+	}
 	data, err := proto.Marshal(packet)
 	if err != nil {
 		return
 	}
-	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_ROUND_END), data, nil, nil, true)
+	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_CHOP), data, nil, nil, true)
 }
 
 func sendGameOver(dispatcher runtime.MatchDispatcher, ev tienlen.GameOver) {
-	packet := &pb.GameOverPacket{WinnerId: ev.WinnerID}
+	packet := &pb.GameOverPacket{
+		WinnerId:  ev.WinnerID,
+		Standings: ev.Settlement.Standings,
+		Results:   toPBResults(ev.Settlement.Results),
+	}
+	if r := ev.Reveal; r != nil {
+		packet.Deal = &pb.DealReveal{
+			ServerSeed:    r.ServerSeed,
+			SeedHash:      r.SeedHash,
+			ClientEntropy: r.ClientEntropy,
+			Commitment:    r.Commitment,
+			PlayerIds:     r.Players,
+			Deck:          toPBCards(r.Deck),
+			HandSize:      int32(r.HandSize),
+			Direction:     string(r.Direction),
+		}
+	}
 	data, err := proto.Marshal(packet)
 	if err != nil {
 		return
@@ -159,6 +278,49 @@ func sendGameOver(dispatcher runtime.MatchDispatcher, ev tienlen.GameOver) {
 	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_GAME_OVER), data, nil, nil, true)
 }
 
+func toPBResults(results []tienlen.PlayerResult) []*pb.PlayerResult {
+	out := make([]*pb.PlayerResult, 0, len(results))
+	for _, r := range results {
+		out = append(out, &pb.PlayerResult{
+			PlayerId:        r.PlayerID,
+			Place:           int32(r.Place),
+			Cong:            r.Cong,
+			Abandoned:       r.Abandoned,
+			RemainingHand:   toPBCards(r.RemainingHand),
+			PlacementPoints: int32(r.PlacementPoints),
+			CongPoints:      int32(r.CongPoints),
+			LeftoverPoints:  int32(r.LeftoverPoints),
+			ChopPoints:      int32(r.ChopPoints),
+			Total:           int32(r.Total),
+		})
+	}
+	return out
+}
+
+// FromPBCards converts cards received from a client into domain cards.
+func FromPBCards(cards []*pb.Card) []tienlen.Card {
+	out := make([]tienlen.Card, 0, len(cards))
+	for _, c := range cards {
+		out = append(out, tienlen.Card{Suit: c.GetSuit(), Rank: c.GetRank()})
+	}
+	return out
+}
+
+// toPBCard converts an optional card; nil stays nil.
+func toPBCard(c *tienlen.Card) *pb.Card {
+	if c == nil {
+		return nil
+	}
+	return &pb.Card{Suit: c.Suit, Rank: c.Rank}
+}
+
+func fromPBCard(c *pb.Card) *tienlen.Card {
+	if c == nil {
+		return nil
+	}
+	return &tienlen.Card{Suit: c.GetSuit(), Rank: c.GetRank()}
+}
+
 func toPBCards(cards []tienlen.Card) []*pb.Card {
 	out := make([]*pb.Card, 0, len(cards))
 	for _, c := range cards {
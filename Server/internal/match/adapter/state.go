@@ -0,0 +1,217 @@
+package adapter
+
+import (
+	"sort"
+
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+	"github.com/yourusername/tienlen-server/pb"
+)
+
+// ToPBGameState converts the engine state into its protobuf form for storage or transfer.
+func ToPBGameState(state tienlen.GameState) *pb.GameState {
+	out := &pb.GameState{
+		Version:           int32(state.Version),
+		Variant:           string(state.Variant),
+		Seed:              state.Seed,
+		IsPlaying:         state.IsPlaying,
+		OwnerId:           state.OwnerID,
+		Players:           state.Players,
+		TurnOrder:         state.TurnOrder,
+		CurrentIdx:        int32(state.CurrentIdx),
+		Hands:             make(map[string]*pb.CardList, len(state.Hands)),
+		HandVersions:      toPBCounts(state.HandVersions),
+		Deck:              toPBCards(state.Deck),
+		Board:             toPBCards(state.Board),
+		LastActor:         state.LastActor,
+		RoundSkippers:     setMembers(state.RoundSkippers),
+		ChopChainOpen:     state.ChopChainOpen,
+		Winners:           state.Winners,
+		FinishedPlayers:   setMembers(state.FinishedPlayers),
+		CardsPlayed:       toPBCounts(state.CardsPlayed),
+		EndedByInstantWin: state.EndedByInstantWin,
+		Abandoned:         setMembers(state.Abandoned),
+		Commitment:        toPBCommitment(state.Commitment),
+		Log:               ToPBLog(state.Log),
+		Deal:              toPBDeal(state.Deal),
+		HandSize:          int32(state.HandSize),
+		OpeningCard:       toPBCard(state.OpeningCard),
+	}
+	for uid, hand := range state.Hands {
+		out.Hands[uid] = &pb.CardList{Cards: toPBCards(hand)}
+	}
+	for _, chop := range state.Chops {
+		out.Chops = append(out.Chops, &pb.ChopPacket{
+			ChopperId:    chop.ChopperID,
+			VictimId:     chop.VictimID,
+			ChoppedCards: toPBCards(chop.Chopped),
+			BombCards:    toPBCards(chop.Bomb),
+			Penalty:      int32(chop.Penalty),
+			Chain:        int32(chop.Chain),
+		})
+	}
+	return out
+}
+
+// FromPBGameState converts a stored protobuf game state back into the engine form.
+// Pass the result to tienlen.RestoreGame.
+func FromPBGameState(in *pb.GameState) tienlen.GameState {
+	state := tienlen.GameState{
+		Version:           int(in.GetVersion()),
+		Variant:           tienlen.Variant(in.GetVariant()),
+		Seed:              in.GetSeed(),
+		IsPlaying:         in.GetIsPlaying(),
+		OwnerID:           in.GetOwnerId(),
+		Players:           in.GetPlayers(),
+		TurnOrder:         in.GetTurnOrder(),
+		CurrentIdx:        int(in.GetCurrentIdx()),
+		Hands:             make(map[string][]tienlen.Card, len(in.GetHands())),
+		HandVersions:      fromPBCounts(in.GetHandVersions()),
+		Deck:              FromPBCards(in.GetDeck()),
+		Board:             FromPBCards(in.GetBoard()),
+		LastActor:         in.GetLastActor(),
+		RoundSkippers:     memberSet(in.GetRoundSkippers()),
+		ChopChainOpen:     in.GetChopChainOpen(),
+		Winners:           in.GetWinners(),
+		FinishedPlayers:   memberSet(in.GetFinishedPlayers()),
+		CardsPlayed:       fromPBCounts(in.GetCardsPlayed()),
+		EndedByInstantWin: in.GetEndedByInstantWin(),
+		Abandoned:         memberSet(in.GetAbandoned()),
+		Commitment:        fromPBCommitment(in.GetCommitment()),
+		Log:               FromPBLog(in.GetLog()),
+		Deal:              fromPBDeal(in.GetDeal()),
+		HandSize:          int(in.GetHandSize()),
+		OpeningCard:       fromPBCard(in.GetOpeningCard()),
+	}
+	for uid, hand := range in.GetHands() {
+		state.Hands[uid] = FromPBCards(hand.GetCards())
+	}
+	for _, chop := range in.GetChops() {
+		state.Chops = append(state.Chops, tienlen.ChopOccurred{
+			ChopperID: chop.GetChopperId(),
+			VictimID:  chop.GetVictimId(),
+			Chopped:   FromPBCards(chop.GetChoppedCards()),
+			Bomb:      FromPBCards(chop.GetBombCards()),
+			Penalty:   int(chop.GetPenalty()),
+			Chain:     int(chop.GetChain()),
+		})
+	}
+	return state
+}
+
+// ToPBLog converts a game log into its protobuf form.
+func ToPBLog(log []tienlen.LogEntry) []*pb.LogEntry {
+	out := make([]*pb.LogEntry, 0, len(log))
+	for _, e := range log {
+		out = append(out, &pb.LogEntry{
+			Seq:          int32(e.Seq),
+			Kind:         string(e.Kind),
+			PlayerId:     e.PlayerID,
+			Cards:        toPBCards(e.Cards),
+			Variant:      string(e.Variant),
+			Seed:         e.Seed,
+			PlayerIds:    e.Players,
+			OwnerId:      e.OwnerID,
+			LastWinnerId: e.LastWinnerID,
+			Commitment:   toPBCommitment(e.Commitment),
+			Deal:         toPBDeal(e.Deal),
+		})
+	}
+	return out
+}
+
+// FromPBLog converts a protobuf game log back into the engine form for tienlen.Replay.
+func FromPBLog(in []*pb.LogEntry) []tienlen.LogEntry {
+	out := make([]tienlen.LogEntry, 0, len(in))
+	for _, e := range in {
+		entry := tienlen.LogEntry{
+			Seq:          int(e.GetSeq()),
+			Kind:         tienlen.CommandKind(e.GetKind()),
+			PlayerID:     e.GetPlayerId(),
+			Variant:      tienlen.Variant(e.GetVariant()),
+			Seed:         e.GetSeed(),
+			Players:      e.GetPlayerIds(),
+			OwnerID:      e.GetOwnerId(),
+			LastWinnerID: e.GetLastWinnerId(),
+			Commitment:   fromPBCommitment(e.GetCommitment()),
+			Deal:         fromPBDeal(e.GetDeal()),
+		}
+		// Entries only carry the fields of their kind; keep the others nil as the engine does.
+		if len(e.GetCards()) > 0 {
+			entry.Cards = FromPBCards(e.GetCards())
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+func toPBCommitment(c *tienlen.DealCommitment) *pb.DealReveal {
+	if c == nil {
+		return nil
+	}
+	return &pb.DealReveal{
+		ServerSeed:    c.ServerSeed,
+		SeedHash:      c.SeedHash,
+		ClientEntropy: c.ClientEntropy,
+		Commitment:    c.Commitment,
+	}
+}
+
+func fromPBCommitment(c *pb.DealReveal) *tienlen.DealCommitment {
+	if c == nil {
+		return nil
+	}
+	return &tienlen.DealCommitment{
+		ServerSeed:    c.GetServerSeed(),
+		SeedHash:      c.GetSeedHash(),
+		ClientEntropy: c.GetClientEntropy(),
+		Commitment:    c.GetCommitment(),
+	}
+}
+
+func toPBDeal(d tienlen.DealConfig) *pb.DealConfig {
+	return &pb.DealConfig{HandSize: int32(d.HandSize), DealAll: d.DealAll, Direction: string(d.Direction)}
+}
+
+func fromPBDeal(d *pb.DealConfig) tienlen.DealConfig {
+	return tienlen.DealConfig{
+		HandSize:  int(d.GetHandSize()),
+		DealAll:   d.GetDealAll(),
+		Direction: tienlen.Direction(d.GetDirection()),
+	}
+}
+
+func toPBCounts(in map[string]int) map[string]int32 {
+	out := make(map[string]int32, len(in))
+	for k, v := range in {
+		out[k] = int32(v)
+	}
+	return out
+}
+
+func fromPBCounts(in map[string]int32) map[string]int {
+	out := make(map[string]int, len(in))
+	for k, v := range in {
+		out[k] = int(v)
+	}
+	return out
+}
+
+// setMembers lists the members of a set in sorted order, so encoding is deterministic.
+func setMembers(set map[string]bool) []string {
+	out := make([]string, 0, len(set))
+	for k, v := range set {
+		if v {
+			out = append(out, k)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func memberSet(members []string) map[string]bool {
+	out := make(map[string]bool, len(members))
+	for _, k := range members {
+		out[k] = true
+	}
+	return out
+}
@@ -0,0 +1,159 @@
+package match
+
+import (
+	"time"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/yourusername/tienlen-server/internal/match/adapter"
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+)
+
+// The turn clock defaults to defaultTurnSeconds; rooms may pick up to maxTurnSeconds, or 0
+// to play without one. A time bank may hold up to maxTimeBankSeconds plus an increment of
+// up to maxIncrementSeconds per turn. With both, a turn ends at whichever runs out first.
+const (
+	defaultTurnSeconds  = 30
+	maxTurnSeconds      = 300
+	maxTimeBankSeconds  = 3600
+	maxIncrementSeconds = 60
+)
+
+// now is the server clock used for turn deadlines sent to clients.
+var now = time.Now
+
+// hasTurnClock reports whether turns are timed at all.
+func (s *MatchState) hasTurnClock() bool {
+	return s.Config.TurnSeconds > 0 || s.Config.TimeBankSeconds > 0
+}
+
+// resetTimeBanks fills every player's bank for a new game.
+func (s *MatchState) resetTimeBanks(players []string) {
+	s.TimeBanks = make(map[string]int64, len(players))
+	s.TurnPlayerID = ""
+	if s.Config.TimeBankSeconds == 0 {
+		return
+	}
+	for _, uid := range players {
+		s.TimeBanks[uid] = int64(s.Config.TimeBankSeconds * tickRate)
+	}
+}
+
+// timesTurn reports whether the turns of playerID run on a clock. Without a room clock,
+// only disconnected players are timed.
+func (s *MatchState) timesTurn(playerID string) bool {
+	_, away := s.Disconnected[playerID]
+	return s.hasTurnClock() || away
+}
+
+// restartTurnClock starts a fresh turn clock when events hand the turn to a player, and
+// stops it once the game is over. It returns the clock to send with the turn update.
+func (s *MatchState) restartTurnClock(events []tienlen.Event) adapter.TurnClock {
+	if !s.Game.IsPlaying() {
+		s.stopTurnClock()
+		return adapter.TurnClock{}
+	}
+	for _, ev := range events {
+		if _, ok := ev.(tienlen.TurnChanged); ok {
+			s.chargeTimeBank()
+			if active := s.Game.Snapshot().ActivePlayerID; s.timesTurn(active) {
+				s.startTurn(active)
+			} else {
+				s.stopTurnClock()
+			}
+			break
+		}
+	}
+	if s.TurnPlayerID == "" {
+		return adapter.TurnClock{}
+	}
+	return adapter.TurnClock{
+		SecondsRemaining: s.secondsRemaining(),
+		Deadline:         s.TurnDeadline,
+		TimeBanks:        s.timeBanksMs(),
+	}
+}
+
+// chargeTimeBank takes the ticks the finished turn used from its player's bank. A player
+// who still has time left earns the increment; one whose bank ran out stays at zero and
+// is timed out on every later turn.
+func (s *MatchState) chargeTimeBank() {
+	if s.Config.TimeBankSeconds == 0 || s.TurnPlayerID == "" {
+		return
+	}
+	bank := s.TimeBanks[s.TurnPlayerID] - (s.Tick - s.TurnStartTick)
+	if bank > 0 {
+		bank += int64(s.Config.IncrementSeconds * tickRate)
+	} else {
+		bank = 0
+	}
+	s.TimeBanks[s.TurnPlayerID] = bank
+}
+
+// startTurn sets the deadline of playerID's turn from the turn clock and their time bank.
+func (s *MatchState) startTurn(playerID string) {
+	limit := int64(-1)
+	if s.Config.TurnSeconds > 0 {
+		limit = int64(s.Config.TurnSeconds * tickRate)
+	}
+	if bank, ok := s.TimeBanks[playerID]; ok && s.Config.TimeBankSeconds > 0 && (limit < 0 || bank < limit) {
+		limit = bank
+	}
+	if _, away := s.Disconnected[playerID]; away && limit < 0 {
+		limit = int64(defaultTurnSeconds * tickRate)
+	}
+	if limit < 0 {
+		limit = 0 // Not dealt in, e.g. a restored table; time the turn out at once
+	}
+	s.TurnPlayerID = playerID
+	s.TurnStartTick = s.Tick
+	s.TurnDeadlineTick = s.Tick + limit
+	s.TurnDeadline = now().Add(time.Duration(limit) * time.Second / tickRate).UnixMilli()
+}
+
+// stopTurnClock clears the clock while nobody's turn is timed.
+func (s *MatchState) stopTurnClock() {
+	s.TurnPlayerID, s.TurnDeadlineTick, s.TurnDeadline = "", 0, 0
+}
+
+// secondsRemaining rounds the time left on the turn clock up to whole seconds.
+func (s *MatchState) secondsRemaining() int {
+	if s.TurnPlayerID == "" || s.Tick >= s.TurnDeadlineTick {
+		return 0
+	}
+	return int((s.TurnDeadlineTick - s.Tick + tickRate - 1) / tickRate)
+}
+
+// timeBanksMs reports every player's remaining bank in milliseconds, counting the time
+// the active player has used so far. It is nil when the room has no time bank.
+func (s *MatchState) timeBanksMs() map[string]int64 {
+	if s.Config.TimeBankSeconds == 0 || len(s.TimeBanks) == 0 {
+		return nil
+	}
+	out := make(map[string]int64, len(s.TimeBanks))
+	for uid, bank := range s.TimeBanks {
+		if uid == s.TurnPlayerID {
+			bank -= s.Tick - s.TurnStartTick
+		}
+		if bank < 0 {
+			bank = 0
+		}
+		out[uid] = bank * 1000 / tickRate
+	}
+	return out
+}
+
+// runTurnClock times out the active player once their turn clock has run out.
+func (m *Match) runTurnClock(s *MatchState, dispatcher runtime.MatchDispatcher, logger runtime.Logger, tick int64) {
+	if !s.Game.IsPlaying() || s.TurnPlayerID == "" || tick < s.TurnDeadlineTick {
+		return
+	}
+	active := s.Game.Snapshot().ActivePlayerID
+	events, err := s.Game.Timeout(active)
+	if err != nil {
+		logger.Warn("Could not time out %s: %v", active, err)
+		s.TurnPlayerID = ""
+		return
+	}
+	logger.Info("Turn of %s timed out", active)
+	m.dispatchGameEvents(s, dispatcher, events)
+}
@@ -0,0 +1,186 @@
+package match
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+	"github.com/yourusername/tienlen-server/pb"
+)
+
+// A room may hold up to maxSpectators watchers besides its players, defaultMaxSpectators
+// unless configured otherwise, and play for a stake of up to maxStake chips per point.
+const (
+	defaultMaxSpectators = 8
+	maxSpectators        = 50
+	maxStake             = 1000000
+	maxPasswordLength    = 64
+)
+
+// RoomConfig is the configuration of a room, chosen when the create_match RPC creates it and
+// fixed for the life of the match. Its JSON form is both the RPC payload and the MatchInit
+// params; fields left out keep their DefaultRoomConfig values.
+type RoomConfig struct {
+	Variant            tienlen.Variant `json:"variant"`
+	Seats              int             `json:"seats"` // 2 to tienlen.MaxPlayers; five or more are dealt two decks
+	tienlen.DealConfig                 // hand_size, deal_all and direction
+
+	TurnSeconds            int `json:"turn_seconds"`      // Turn clock; 0 plays without one
+	TimeBankSeconds        int `json:"time_bank_seconds"` // Chess clock bank per game; 0 plays without one
+	IncrementSeconds       int `json:"increment_seconds"` // Added to the bank after every turn
+	DisconnectGraceSeconds int `json:"disconnect_grace_seconds"`
+
+	Stake         int  `json:"stake"`          // Chips per settlement point; 0 plays for fun
+	Private       bool `json:"private"`        // Kept out of quick match
+	HintsAllowed  bool `json:"hints_allowed"`  // Enables OP_HINT_REQUEST; ranked rooms turn it off
+	MaxSpectators int  `json:"max_spectators"` // 0 admits no spectators
+	AutoRestart   bool `json:"auto_restart"`   // Deal every game after the first without a ready check
+
+	// Password, if set, must be given as the "password" join metadata. JoinCode is assigned
+	// to private rooms by the create_match RPC, and newcomers must give it as the "join_code"
+	// join metadata.
+	Password string `json:"password,omitempty"`
+	JoinCode string `json:"join_code,omitempty"`
+}
+
+// DefaultRoomConfig returns the configuration of a room created without one, as by quick match.
+func DefaultRoomConfig() RoomConfig {
+	return RoomConfig{
+		Variant:                tienlen.VariantSouthern,
+		Seats:                  defaultSeats,
+		DealConfig:             tienlen.DealConfig{Direction: tienlen.CounterClockwise},
+		TurnSeconds:            defaultTurnSeconds,
+		DisconnectGraceSeconds: defaultGraceSeconds,
+		HintsAllowed:           true,
+		MaxSpectators:          defaultMaxSpectators,
+	}
+}
+
+// ParseRoomConfig reads a room configuration from a create_match payload and validates it.
+// An empty payload gives the defaults; unknown fields are rejected so typos do not go unnoticed.
+func ParseRoomConfig(payload string) (RoomConfig, error) {
+	c := DefaultRoomConfig()
+	if payload != "" {
+		dec := json.NewDecoder(bytes.NewReader([]byte(payload)))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&c); err != nil {
+			return RoomConfig{}, fmt.Errorf("invalid room config: %v", err)
+		}
+	}
+	if c.Direction == "" {
+		c.Direction = tienlen.CounterClockwise
+	}
+	if err := c.Validate(); err != nil {
+		return RoomConfig{}, err
+	}
+	return c, nil
+}
+
+// Validate reports the first setting that is out of range.
+func (c RoomConfig) Validate() error {
+	if _, err := tienlen.RuleSetFor(c.Variant); err != nil {
+		return err
+	}
+	switch {
+	case c.Seats < 2 || c.Seats > tienlen.MaxPlayers:
+		return fmt.Errorf("seats must be between 2 and %d", tienlen.MaxPlayers)
+	case c.HandSize < 0 || c.HandSize > tienlen.DefaultHandSize*2:
+		return fmt.Errorf("hand_size must be between 0 and %d", tienlen.DefaultHandSize*2)
+	case !handSizeFits(c.HandSize, c.Seats):
+		return fmt.Errorf("hand_size %d needs more cards than the deck holds at %d seats", c.HandSize, c.Seats)
+	case c.Direction != tienlen.CounterClockwise && c.Direction != tienlen.Clockwise:
+		return fmt.Errorf("direction must be %q or %q", tienlen.CounterClockwise, tienlen.Clockwise)
+	case c.TurnSeconds < 0 || c.TurnSeconds > maxTurnSeconds:
+		return fmt.Errorf("turn_seconds must be between 0 and %d", maxTurnSeconds)
+	case c.TimeBankSeconds < 0 || c.TimeBankSeconds > maxTimeBankSeconds:
+		return fmt.Errorf("time_bank_seconds must be between 0 and %d", maxTimeBankSeconds)
+	case c.IncrementSeconds < 0 || c.IncrementSeconds > maxIncrementSeconds:
+		return fmt.Errorf("increment_seconds must be between 0 and %d", maxIncrementSeconds)
+	case c.IncrementSeconds > 0 && c.TimeBankSeconds == 0:
+		return fmt.Errorf("increment_seconds needs a time_bank_seconds")
+	case c.DisconnectGraceSeconds < 0 || c.DisconnectGraceSeconds > maxGraceSeconds:
+		return fmt.Errorf("disconnect_grace_seconds must be between 0 and %d", maxGraceSeconds)
+	case c.Stake < 0 || c.Stake > maxStake:
+		return fmt.Errorf("stake must be between 0 and %d", maxStake)
+	case c.MaxSpectators < 0 || c.MaxSpectators > maxSpectators:
+		return fmt.Errorf("max_spectators must be between 0 and %d", maxSpectators)
+	case len(c.Password) > maxPasswordLength:
+		return fmt.Errorf("password must be at most %d characters", maxPasswordLength)
+	}
+	return nil
+}
+
+// handSizeFits reports whether handSize cards can be dealt to every table size the room may
+// start with, from minPlayers up to seats. Fewer players may be dealt from fewer decks.
+func handSizeFits(handSize, seats int) bool {
+	for n := minPlayers; n <= seats; n++ {
+		if handSize*n > 52*tienlen.DecksFor(n) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkJoinCode reports whether code, as typed by a player, is the room's join code. Rooms
+// without one need none.
+func (c RoomConfig) checkJoinCode(code string) bool {
+	return c.JoinCode == "" || strings.ToUpper(strings.TrimSpace(code)) == c.JoinCode
+}
+
+// checkPassword reports whether password opens the room.
+func (c RoomConfig) checkPassword(password string) bool {
+	if c.Password == "" {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(password), []byte(c.Password)) == 1
+}
+
+// Params encodes the configuration as MatchInit params for nk.MatchCreate.
+func (c RoomConfig) Params() map[string]interface{} {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil
+	}
+	return params
+}
+
+// roomConfigFromParams reads the configuration back from the params given to MatchInit.
+func roomConfigFromParams(params map[string]interface{}) (RoomConfig, error) {
+	if len(params) == 0 {
+		return DefaultRoomConfig(), nil
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return RoomConfig{}, fmt.Errorf("invalid room config: %v", err)
+	}
+	return ParseRoomConfig(string(data))
+}
+
+// toPB converts the configuration for match state packets. The password itself is never sent.
+func (c RoomConfig) toPB() *pb.RoomConfig {
+	return &pb.RoomConfig{
+		Variant:                string(c.Variant),
+		Seats:                  int32(c.Seats),
+		HandSize:               int32(c.HandSize),
+		DealAll:                c.DealAll,
+		Direction:              string(c.Direction),
+		TurnSeconds:            int32(c.TurnSeconds),
+		TimeBankSeconds:        int32(c.TimeBankSeconds),
+		IncrementSeconds:       int32(c.IncrementSeconds),
+		DisconnectGraceSeconds: int32(c.DisconnectGraceSeconds),
+		Stake:                  int64(c.Stake),
+		Private:                c.Private,
+		HintsAllowed:           c.HintsAllowed,
+		MaxSpectators:          int32(c.MaxSpectators),
+		AutoRestart:            c.AutoRestart,
+		HasPassword:            c.Password != "",
+		JoinCode:               c.JoinCode,
+	}
+}
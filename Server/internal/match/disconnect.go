@@ -0,0 +1,123 @@
+package match
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/yourusername/tienlen-server/internal/bot"
+	"github.com/yourusername/tienlen-server/internal/match/adapter"
+)
+
+// A player who drops out of a game keeps their seat and hand for defaultGraceSeconds, or the
+// disconnect_grace_seconds of the room config, up to maxGraceSeconds. Their turns keep running on
+// the turn clock meanwhile, and once the grace is over a bot takes over their hand.
+const (
+	defaultGraceSeconds = 60
+	maxGraceSeconds     = 600
+)
+
+// holdSeat keeps the seat and hand of a player who disconnected mid-game until their
+// grace runs out. If they hold the turn in a room without a turn clock, one is started
+// for them so the table does not stall.
+func (m *Match) holdSeat(s *MatchState, logger runtime.Logger, userID string) {
+	s.Disconnected[userID] = s.Tick + int64(s.Config.DisconnectGraceSeconds*tickRate)
+	if s.Game.Snapshot().ActivePlayerID == userID && s.TurnPlayerID != userID {
+		s.startTurn(userID)
+	}
+	logger.Info("Player %s disconnected, holding their seat for %d seconds", userID, s.Config.DisconnectGraceSeconds)
+}
+
+// reconnect hands a held seat back to its player. The caller sends them the match state
+// and their hand.
+func (m *Match) reconnect(s *MatchState, dispatcher runtime.MatchDispatcher, logger runtime.Logger, userID string) {
+	if _, held := s.Disconnected[userID]; !held {
+		return
+	}
+	delete(s.Disconnected, userID)
+	if !s.hasTurnClock() && s.TurnPlayerID == userID {
+		s.stopTurnClock()
+	}
+	logger.Info("Player %s reconnected", userID)
+	adapter.BroadcastPlayerJoined(dispatcher, s.table(), s.Game)
+}
+
+// runDisconnects hands the seat of every player whose grace ran out to a bot, and releases
+// held and taken-over seats once the game is over. A player who came back to watch the
+// bot gets their taken-over seat back instead. Players who already went out have no
+// hand left to play, so their seats stay held until then. It reports whether seats were
+// released.
+func (m *Match) runDisconnects(s *MatchState, dispatcher runtime.MatchDispatcher, logger runtime.Logger, tick int64) bool {
+	if s.Game.IsPlaying() {
+		for uid, expires := range s.Disconnected {
+			if tick >= expires && !s.Game.FinishedPlayers[uid] {
+				m.takeOver(s, dispatcher, logger, uid)
+			}
+		}
+		return false
+	}
+
+	released := false
+	for slot, seat := range s.Seats {
+		if !seat.TakenOver {
+			continue
+		}
+		delete(s.Bots, seat.UserID)
+		released = true
+		if _, present := s.Presences[seat.UserID]; present {
+			s.Seats[slot] = Seat{UserID: seat.UserID}
+			delete(s.Spectators, seat.UserID)
+			logger.Info("Gave %s their seat back", seat.UserID)
+			continue
+		}
+		m.freeSeat(s, dispatcher, seat.UserID)
+		logger.Info("Released the seat of %s", seat.UserID)
+	}
+	for uid := range s.Disconnected {
+		m.freeSeat(s, dispatcher, uid)
+		delete(s.Disconnected, uid)
+		logger.Info("Released the seat of %s", uid)
+		released = true
+	}
+	if released {
+		adapter.BroadcastPlayerLeft(dispatcher, s.table(), s.Game)
+	}
+	return released
+}
+
+// takeOver gives the seat of a player whose grace ran out to a bot, which plays out their
+// hand so the others can finish. The game records that the player abandoned it.
+func (m *Match) takeOver(s *MatchState, dispatcher runtime.MatchDispatcher, logger runtime.Logger, userID string) {
+	delete(s.Disconnected, userID)
+	if err := s.Game.Abandon(userID); err != nil {
+		logger.Warn("Could not hand %s over to a bot: %v", userID, err)
+		return
+	}
+	b, err := bot.New(bot.StrategyGreedy, rand.New(rand.NewSource(newSeed())))
+	if err != nil {
+		logger.Warn("Could not hand %s over to a bot: %v", userID, err)
+		return
+	}
+	s.Bots[userID] = b
+	if slot, ok := s.SeatByUser[userID]; ok {
+		s.Seats[slot] = Seat{UserID: userID, Bot: bot.StrategyGreedy, TakenOver: true}
+	}
+	if !s.hasTurnClock() && s.TurnPlayerID == userID {
+		s.stopTurnClock()
+	}
+	logger.Info("Grace of %s is over, a bot takes over their hand", userID)
+	adapter.BroadcastPlayerLeft(dispatcher, s.table(), s.Game)
+}
+
+// disconnectedIDs lists the players whose seats are held, in sorted order.
+func (s *MatchState) disconnectedIDs() []string {
+	if len(s.Disconnected) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(s.Disconnected))
+	for uid := range s.Disconnected {
+		out = append(out, uid)
+	}
+	sort.Strings(out)
+	return out
+}
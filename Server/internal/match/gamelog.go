@@ -0,0 +1,48 @@
+package match
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+)
+
+// The command log of every finished game is saved in the gameLogCollection storage
+// collection under "<match ID>/<game number>", owned by the system user and hidden from
+// clients. It outlives the match, so a disputed game can be replayed with tienlen.Replay
+// from the console or an admin tool.
+const gameLogCollection = "game_logs"
+
+// storedGameLog is the stored value; Nakama keeps JSON objects, not bare arrays.
+type storedGameLog struct {
+	MatchID string             `json:"match_id"`
+	Game    int                `json:"game"`
+	Log     []tienlen.LogEntry `json:"log"`
+}
+
+// gameLogKey is the storage key of the given game of a match.
+func gameLogKey(matchID string, game int) string {
+	return fmt.Sprintf("%s/%d", matchID, game)
+}
+
+// saveGameLog stores LastGameLog as the log of game number GamesPlayed.
+func (m *Match) saveGameLog(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, s *MatchState) {
+	s.LogPending = false
+	matchID, _ := ctx.Value(runtime.RUNTIME_CTX_MATCH_ID).(string)
+	value, err := json.Marshal(storedGameLog{MatchID: matchID, Game: s.GamesPlayed, Log: s.LastGameLog})
+	if err != nil {
+		logger.Error("Failed to encode game log: %v", err)
+		return
+	}
+	if _, err := nk.StorageWrite(ctx, []*runtime.StorageWrite{{
+		Collection:      gameLogCollection,
+		Key:             gameLogKey(matchID, s.GamesPlayed),
+		Value:           string(value),
+		PermissionRead:  0, // No client access
+		PermissionWrite: 0,
+	}}); err != nil {
+		logger.Error("Failed to save the log of game %d: %v", s.GamesPlayed, err)
+	}
+}
@@ -0,0 +1,86 @@
+package match
+
+import (
+	"encoding/json"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+)
+
+// Label is the JSON match label, kept up to date as players come and go and the match moves
+// between phases. Quick match finds tables through Nakama's label query on its fields, e.g.
+// "+label.open_seats:>=1 +label.phase:waiting".
+type Label struct {
+	OpenSeats  int             `json:"open_seats"`
+	Phase      Phase           `json:"phase"`
+	Variant    tienlen.Variant `json:"variant"`
+	StakeTier  string          `json:"stake_tier"` // See StakeTier
+	Private    bool            `json:"private"`
+	Password   bool            `json:"password"` // Joining needs a password
+	Spectators int             `json:"spectators"`
+}
+
+// Stake tiers group rooms by stake for matchmaking.
+const (
+	StakeFree = "free"
+	StakeLow  = "low"  // Under 100 chips a point
+	StakeMid  = "mid"  // Under 1000
+	StakeHigh = "high" // 1000 and up
+)
+
+// StakeTier returns the tier of a stake.
+func StakeTier(stake int) string {
+	switch {
+	case stake == 0:
+		return StakeFree
+	case stake < 100:
+		return StakeLow
+	case stake < 1000:
+		return StakeMid
+	}
+	return StakeHigh
+}
+
+// TierStake returns the stake a new room of the given tier plays for: the bottom of the
+// tier, or 10 for the low tier.
+func TierStake(tier string) int {
+	switch tier {
+	case StakeLow:
+		return 10
+	case StakeMid:
+		return 100
+	case StakeHigh:
+		return 1000
+	}
+	return 0
+}
+
+// label describes the match as it stands.
+func (m *Match) label(s *MatchState) string {
+	data, err := json.Marshal(Label{
+		OpenSeats:  len(s.Seats) - len(s.SeatByUser),
+		Phase:      s.Phase,
+		Variant:    s.Config.Variant,
+		StakeTier:  StakeTier(s.Config.Stake),
+		Private:    s.Config.Private,
+		Password:   s.Config.Password != "",
+		Spectators: s.watchers(),
+	})
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// updateLabel publishes the label if it changed since it was last published.
+func (m *Match) updateLabel(s *MatchState, dispatcher runtime.MatchDispatcher, logger runtime.Logger) {
+	label := m.label(s)
+	if label == s.Label {
+		return
+	}
+	if err := dispatcher.MatchLabelUpdate(label); err != nil {
+		logger.Warn("Failed to update match label: %v", err)
+		return
+	}
+	s.Label = label
+}
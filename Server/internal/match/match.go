@@ -3,48 +3,212 @@ package match
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math/rand"
-	"time"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/yourusername/tienlen-server/internal/bot"
 	"github.com/yourusername/tienlen-server/internal/match/adapter"
 	"github.com/yourusername/tienlen-server/internal/tienlen"
 	"github.com/yourusername/tienlen-server/pb"
 	"google.golang.org/protobuf/proto"
 )
 
-// MatchState keeps Nakama-specific session data and the domain game engine.
+// MatchState keeps Nakama-specific session data and the domain game engine. It can be
+// serialized as JSON to persist the table or move it between nodes; see UnmarshalJSON.
 type MatchState struct {
-	Presences  map[string]runtime.Presence `json:"presences"`
+	Presences  map[string]runtime.Presence `json:"-"` // Sessions stay on their node; players join again
 	Spectators map[string]bool             `json:"spectators"`
 	OwnerID    string                      `json:"owner_id"`
-	Game       *tienlen.Game               `json:"-"`
-	Seats      [4]string                   `json:"seats"`           // seat index -> userID (empty string means free)
+	Game       *tienlen.Game               `json:"game"`
+	Seats      []Seat                      `json:"seats"`           // Sized at MatchInit, see defaultSeats
 	SeatByUser map[string]int              `json:"seat_by_user_id"` // userID -> seat index
 
+	// Phase is where the match is in its lifecycle, see phase.go. A timed phase ends at
+	// PhaseEndsAtTick, sent to clients as PhaseDeadline in Unix milliseconds. Ready holds
+	// the seated players who confirmed with OP_READY for the next game. DealFailed is set
+	// when the last deal failed, so even auto-restart rooms wait for everyone to ready again.
+	Phase           Phase           `json:"phase"`
+	PhaseEndsAtTick int64           `json:"phase_ends_at_tick"`
+	PhaseDeadline   int64           `json:"phase_deadline"`
+	Ready           map[string]bool `json:"ready"`
+	DealFailed      bool            `json:"deal_failed"`
+
+	// Bots holds the strategy of every bot-occupied seat, keyed by the bot's player ID.
+	// It is rebuilt from Seats when the state is restored.
+	Bots map[string]bot.Bot `json:"-"`
+
+	// BotTurnID and BotActAtTick schedule the pending bot move: the bot holding the turn
+	// acts once the match reaches BotActAtTick.
+	BotTurnID    string `json:"bot_turn_id"`
+	BotActAtTick int64  `json:"bot_act_at_tick"`
+
 	// LastGameWinnerID stores the user ID of the player who won (came in 1st place)
 	// the previous game. This is used to determine who starts the next game.
 	LastGameWinnerID string `json:"last_game_winner_id"`
+
+	// LastGameLog is the command log of the last finished game, kept until the next one ends.
+	// Fetch it with the "last_game_log" match signal and rebuild the game with tienlen.Replay.
+	// GamesPlayed counts the finished games, and LogPending marks a log not yet saved to
+	// storage; see gamelog.go.
+	LastGameLog []tienlen.LogEntry `json:"last_game_log"`
+	GamesPlayed int                `json:"games_played"`
+	LogPending  bool               `json:"log_pending"`
+
+	// Config holds the settings the room was created with; every game in the match is
+	// played by them.
+	Config RoomConfig `json:"config"`
+
+	// A turn that runs past TurnDeadlineTick is timed out by the server. TurnDeadline is the
+	// same moment in Unix milliseconds, as sent to clients. Tick is the tick being processed.
+	TurnDeadlineTick int64 `json:"turn_deadline_tick"`
+	TurnDeadline     int64 `json:"turn_deadline"`
+	Tick             int64 `json:"tick"`
+
+	// TimeBanks holds each player's remaining chess clock bank in ticks as of the start of
+	// TurnPlayerID's turn at TurnStartTick.
+	TimeBanks     map[string]int64 `json:"time_banks"`
+	TurnPlayerID  string           `json:"turn_player_id"`
+	TurnStartTick int64            `json:"turn_start_tick"`
+
+	// Label is the match label last published; see label.go.
+	Label string `json:"label"`
+
+	// Disconnected maps each player who dropped out of a game to the tick at which the hold
+	// on their seat and hand runs out; see disconnect.go.
+	Disconnected map[string]int64 `json:"disconnected"`
+
+	// NextServerSeed is the secret seed of the next deal. It is drawn as soon as the previous
+	// deal is made, and its hash published, before the entropy mixed into the deal is known.
+	// ClientEntropy holds the entropy each player contributed since, through the "entropy"
+	// join metadata or OP_READY.
+	NextServerSeed string            `json:"next_server_seed"`
+	ClientEntropy  map[string]string `json:"client_entropy"`
+}
+
+// Seat is one place at the table, taken by a human or by a server-side bot.
+type Seat struct {
+	UserID string `json:"user_id"`       // Empty string means free
+	Bot    string `json:"bot,omitempty"` // Bot strategy; empty for human players
+
+	// TakenOver marks the seat of a player who left mid-game: a bot plays out their hand
+	// under their user ID, and the seat is freed once the game is over.
+	TakenOver bool `json:"taken_over,omitempty"`
+}
+
+// IsBot reports whether the seat is played by the server.
+func (s Seat) IsBot() bool {
+	return s.UserID != "" && s.Bot != ""
+}
+
+// UnmarshalJSON restores a serialized match state. Presences start out empty until the
+// players join again, and every bot seat gets a fresh bot of its strategy.
+func (s *MatchState) UnmarshalJSON(data []byte) error {
+	type plain MatchState
+	if err := json.Unmarshal(data, (*plain)(s)); err != nil {
+		return err
+	}
+	s.Presences = make(map[string]runtime.Presence)
+	s.Bots = make(map[string]bot.Bot)
+	for _, seat := range s.Seats {
+		if !seat.IsBot() {
+			continue
+		}
+		b, err := bot.New(seat.Bot, rand.New(rand.NewSource(newSeed())))
+		if err != nil {
+			return fmt.Errorf("seat of %s: %v", seat.UserID, err)
+		}
+		s.Bots[seat.UserID] = b
+	}
+	return nil
 }
+
+// Bots wait a random number of ticks within this range before moving, so they play at a
+// human-like pace (0.8s to 2s at 10 ticks per second).
+const (
+	minBotDelayTicks    = 8
+	botDelayJitterTicks = 12
+)
+
+// tickRate is the number of MatchLoop ticks per second.
+const tickRate = 10
+
+// defaultSeats is the table size unless the room config picks another, from 2 up to
+// tienlen.MaxPlayers. Tables of five or more are dealt from two decks.
+const defaultSeats = 4
+
+// maxEntropyLength caps the entropy a player may contribute to deals.
+const maxEntropyLength = 128
+
+// signalLastGameLog is the MatchSignal data that returns LastGameLog as JSON.
+const signalLastGameLog = "last_game_log"
+
+// maxHints caps the number of suggested plays returned for a hint request.
+const maxHints = 5
+
 type Match struct{}
 
+// newServerSeed supplies the secret seed of each committed deal and newSeed seeds bot
+// strategies. Tests replace them to get reproducible games.
+var (
+	newServerSeed = tienlen.NewServerSeed
+	newSeed       = tienlen.NewSeed
+)
+
 func (m *Match) MatchInit(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, params map[string]interface{}) (interface{}, int, string) {
-	logger.Info("Match initialized")
+	// Falling back to the defaults would quietly open a private or password-protected room,
+	// so a bad config fails the match instead. Nakama refuses to create a match without state.
+	config, err := roomConfigFromParams(params)
+	if err != nil {
+		logger.Error("Refusing to create the match: %v", err)
+		return nil, 0, ""
+	}
 	state := &MatchState{
-		Presences:  make(map[string]runtime.Presence),
-		Spectators: make(map[string]bool),
-		Game:       tienlen.NewGame(),
-		SeatByUser: make(map[string]int),
+		Presences:     make(map[string]runtime.Presence),
+		Spectators:    make(map[string]bool),
+		Seats:         make([]Seat, config.Seats),
+		SeatByUser:    make(map[string]int),
+		Phase:         PhaseWaiting,
+		Ready:         make(map[string]bool),
+		Bots:          make(map[string]bot.Bot),
+		ClientEntropy: make(map[string]string),
+		Config:        config,
+		TimeBanks:     make(map[string]int64),
+		Disconnected:  make(map[string]int64),
+	}
+	state.Game = newGame(state.rules(), config.DealConfig)
+	if err := state.prepareDeal(); err != nil {
+		logger.Error("Failed to draw a server seed: %v", err)
 	}
-	return state, 10, "TienLen"
+	state.Label = m.label(state)
+	logger.Info("Match initialized with %s rules", config.Variant)
+	return state, tickRate, state.Label
 }
 
 func (m *Match) MatchJoinAttempt(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presence runtime.Presence, metadata map[string]string) (interface{}, bool, string) {
 	s := state.(*MatchState)
-	if m.findOpenSeat(s) == -1 {
+	_, seated := s.SeatByUser[presence.GetUserId()]
+	// Players coming back to their seat were let in before.
+	if !seated && !s.Config.checkJoinCode(metadata["join_code"]) {
+		return s, false, "This room is private"
+	}
+	if !seated && !s.Config.checkPassword(metadata["password"]) {
+		if metadata["password"] == "" {
+			return s, false, "This room needs a password"
+		}
+		return s, false, "Wrong password"
+	}
+	// Newcomers who find no free seat watch, up to the room's spectator limit. Those who
+	// take a seat during a game wait for the next deal without using up a spectator slot.
+	if !seated && m.findOpenSeat(s) == -1 && s.watchers() >= s.Config.MaxSpectators {
 		return s, false, "Match is full"
 	}
+	s.addEntropy(presence.GetUserId(), metadata["entropy"])
 	return s, true, ""
 }
 
@@ -54,6 +218,10 @@ func (m *Match) MatchJoin(ctx context.Context, logger runtime.Logger, db *sql.DB
 		userID := p.GetUserId()
 		s.Presences[userID] = p
 		m.assignSeat(logger, s, dispatcher, userID)
+		if _, seated := s.SeatByUser[userID]; !seated {
+			s.Spectators[userID] = true
+		}
+		m.reconnect(s, dispatcher, logger, userID)
 
 		if s.OwnerID == "" {
 			s.OwnerID = userID
@@ -64,20 +232,22 @@ func (m *Match) MatchJoin(ctx context.Context, logger runtime.Logger, db *sql.DB
 		logger.Info("Player %s joined match", userID)
 
 		if s.Game.IsPlaying() {
-			if s.Game.HasPlayer(userID) {
-				adapter.SendMatchState(dispatcher, s.Game.Snapshot(), s.Seats[:], p)
-				adapter.SendHand(dispatcher, userID, s.Game.HandOf(userID), []runtime.Presence{p})
+			if s.Game.HasPlayer(userID) && !s.Game.Abandoned(userID) {
+				adapter.SendMatchState(dispatcher, s.Game.Snapshot(), s.table(), p)
+				adapter.SendHand(dispatcher, userID, s.Game.HandOf(userID), s.Game.HandVersion(userID), []runtime.Presence{p})
 			} else {
 				s.Spectators[userID] = true
-				adapter.SendMatchState(dispatcher, s.Game.Snapshot(), s.Seats[:], p)
+				adapter.SendMatchState(dispatcher, s.Game.Snapshot(), s.table(), p)
 			}
 		}
 	}
+	m.updateLabel(s, dispatcher, logger)
 	return s
 }
 
 func (m *Match) MatchLeave(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
 	s := state.(*MatchState)
+	s.Tick = tick
 	ownerLeft := false
 
 	for _, p := range presences {
@@ -85,33 +255,46 @@ func (m *Match) MatchLeave(ctx context.Context, logger runtime.Logger, db *sql.D
 		if userID == s.OwnerID {
 			ownerLeft = true
 		}
-		m.freeSeat(s, dispatcher, userID)
 		delete(s.Presences, userID)
 		delete(s.Spectators, userID)
+		if s.Game.IsPlaying() && s.Game.HasPlayer(userID) {
+			if !s.Game.Abandoned(userID) {
+				m.holdSeat(s, logger, userID)
+			}
+			continue
+		}
+		m.freeSeat(s, dispatcher, userID)
 		logger.Info("Player %s left match", userID)
 	}
 
-	if len(s.Presences) == 0 {
+	if len(s.Presences) == 0 && len(s.Disconnected) == 0 {
 		logger.Info("No players remain, destroying match")
+		m.releaseJoinCode(ctx, logger, nk, s)
 		return nil
 	}
 
 	if ownerLeft {
+		s.OwnerID = ""
 		for uid := range s.Presences {
 			s.OwnerID = uid
 			break
 		}
-		logger.Info("New match owner: %s", s.OwnerID)
-		adapter.BroadcastOwnerUpdate(dispatcher, s.OwnerID)
+		// With nobody connected, the first player to reconnect becomes the owner
+		if s.OwnerID != "" {
+			logger.Info("New match owner: %s", s.OwnerID)
+			adapter.BroadcastOwnerUpdate(dispatcher, s.OwnerID)
+		}
 	}
 
-	adapter.BroadcastPlayerLeft(dispatcher, seatsAsSlice(s.Seats), s.OwnerID, s.Game)
+	adapter.BroadcastPlayerLeft(dispatcher, s.table(), s.Game)
+	m.updateLabel(s, dispatcher, logger)
 
 	return s
 }
 
 func (m *Match) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, messages []runtime.MatchData) interface{} {
 	s := state.(*MatchState)
+	s.Tick = tick
 
 	select {
 	case <-ctx.Done():
@@ -124,14 +307,58 @@ func (m *Match) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB
 		m.handleMessage(s, dispatcher, logger, msg)
 	}
 
+	m.runBots(s, dispatcher, logger, tick)
+	released := m.runDisconnects(s, dispatcher, logger, tick)
+	m.runTurnClock(s, dispatcher, logger, tick)
+	m.runPhase(s, dispatcher, logger, tick)
+	if s.LogPending && nk != nil {
+		m.saveGameLog(ctx, logger, nk, s)
+	}
+
+	if released && len(s.Presences) == 0 && len(s.Disconnected) == 0 {
+		logger.Info("No players remain, destroying match")
+		m.releaseJoinCode(ctx, logger, nk, s)
+		return nil
+	}
+
+	// Phase changes, bots and released seats all happen here.
+	m.updateLabel(s, dispatcher, logger)
 	return s
 }
 
 func (m *Match) MatchTerminate(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, graceSeconds int) interface{} {
+	m.releaseJoinCode(ctx, logger, nk, state.(*MatchState))
 	return state
 }
 
+// JoinCodeCollection is the storage collection mapping the join codes of private rooms to
+// their match IDs. The create_match RPC fills it in and each match releases its code when
+// it ends.
+const JoinCodeCollection = "join_codes"
+
+// releaseJoinCode frees the room's join code for other rooms once the match ends.
+func (m *Match) releaseJoinCode(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, s *MatchState) {
+	if s.Config.JoinCode == "" || nk == nil {
+		return
+	}
+	if err := nk.StorageDelete(ctx, []*runtime.StorageDelete{{Collection: JoinCodeCollection, Key: s.Config.JoinCode}}); err != nil {
+		logger.Error("Failed to release join code %s: %v", s.Config.JoinCode, err)
+	}
+}
+
 func (m *Match) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	s := state.(*MatchState)
+	if data == signalLastGameLog {
+		if s.LastGameLog == nil {
+			return state, ""
+		}
+		encoded, err := json.Marshal(s.LastGameLog)
+		if err != nil {
+			logger.Error("Failed to encode game log: %v", err)
+			return state, ""
+		}
+		return state, string(encoded)
+	}
 	return state, "Signal received: " + data
 }
 
@@ -152,40 +379,64 @@ func (m *Match) handleMessage(s *MatchState, dispatcher runtime.MatchDispatcher,
 
 	switch opCode {
 	case pb.OpCode_OP_GAME_START_REQUEST:
+		// The owner may deal without waiting for the ready check.
+		if senderID != s.OwnerID {
+			sendError(dispatcher, senderPresence, "Only the owner can start the game")
+			return
+		}
 		if s.Game.IsPlaying() {
 			logger.Warn("Rejecting start request. Game is playing. Winners: %d, TurnOrder: %d, IsPlaying: %v", len(s.Game.Winners), len(s.Game.TurnOrder), s.Game.IsPlaying())
 			sendError(dispatcher, senderPresence, "Game already started")
 			return
 		}
-		if err := m.startNewGame(s, dispatcher); err != nil {
+		if err := m.startNow(s, dispatcher, logger); err != nil {
+			sendError(dispatcher, senderPresence, err.Error())
+			return
+		}
+	case pb.OpCode_OP_READY:
+		req := &pb.ReadyRequest{}
+		if err := proto.Unmarshal(msg.GetData(), req); err != nil {
+			sendError(dispatcher, senderPresence, "Invalid ready payload")
+			return
+		}
+		if err := m.ready(s, dispatcher, senderID, req.Entropy); err != nil {
 			sendError(dispatcher, senderPresence, err.Error())
 			return
 		}
 	case pb.OpCode_OP_PLAY_CARD:
+		if s.Game.Abandoned(senderID) {
+			sendError(dispatcher, senderPresence, "A bot is playing your hand")
+			return
+		}
 		req := &pb.PlayCardRequest{}
 		if err := proto.Unmarshal(msg.GetData(), req); err != nil {
 			sendError(dispatcher, senderPresence, "Invalid play request")
 			return
 		}
-		indices := make([]int, 0, len(req.CardIndices))
-		for _, idx := range req.CardIndices {
-			indices = append(indices, int(idx))
-		}
-		events, err := s.Game.PlayCards(senderID, indices)
-
-		// Update last winner after each play
-		if len(s.Game.Winners) != 0 {
-			s.LastGameWinnerID = s.Game.Winners[0]
+		var events []tienlen.Event
+		var err error
+		if len(req.Cards) > 0 {
+			events, err = s.Game.PlayCardValues(senderID, adapter.FromPBCards(req.Cards))
+		} else {
+			indices := make([]int, 0, len(req.CardIndices))
+			for _, idx := range req.CardIndices {
+				indices = append(indices, int(idx))
+			}
+			events, err = s.Game.PlayCardsAt(senderID, indices, int(req.HandVersion))
 		}
-
 		if err != nil {
 			sendError(dispatcher, senderPresence, err.Error())
 			return
 		}
-		adapter.DispatchEvents(dispatcher, s.Presences, events)
+		m.dispatchGameEvents(s, dispatcher, events)
 
 	case pb.OpCode_OP_PASS:
 
+		if s.Game.Abandoned(senderID) {
+			sendError(dispatcher, senderPresence, "A bot is playing your hand")
+			return
+		}
+
 		events, err := s.Game.Pass(senderID)
 
 		if err != nil {
@@ -196,7 +447,41 @@ func (m *Match) handleMessage(s *MatchState, dispatcher runtime.MatchDispatcher,
 
 		}
 
-		adapter.DispatchEvents(dispatcher, s.Presences, events)
+		m.dispatchGameEvents(s, dispatcher, events)
+
+	case pb.OpCode_OP_HINT_REQUEST:
+		if !s.Config.HintsAllowed {
+			sendError(dispatcher, senderPresence, "Hints are disabled in this room")
+			return
+		}
+		hints, canPass, err := s.Game.Hints(senderID, maxHints)
+		if err != nil {
+			sendError(dispatcher, senderPresence, err.Error())
+			return
+		}
+		adapter.SendHints(dispatcher, hints, canPass, senderPresence)
+
+	case pb.OpCode_OP_ADD_BOT:
+		req := &pb.AddBotRequest{}
+		if err := proto.Unmarshal(msg.GetData(), req); err != nil {
+			sendError(dispatcher, senderPresence, "Invalid add bot request")
+			return
+		}
+		if err := m.addBot(s, dispatcher, senderID, req.Strategy); err != nil {
+			sendError(dispatcher, senderPresence, err.Error())
+			return
+		}
+
+	case pb.OpCode_OP_REMOVE_BOT:
+		req := &pb.RemoveBotRequest{}
+		if err := proto.Unmarshal(msg.GetData(), req); err != nil {
+			sendError(dispatcher, senderPresence, "Invalid remove bot request")
+			return
+		}
+		if err := m.removeBot(s, dispatcher, senderID, req.BotId); err != nil {
+			sendError(dispatcher, senderPresence, err.Error())
+			return
+		}
 
 	default:
 
@@ -210,7 +495,7 @@ func (m *Match) handleMessage(s *MatchState, dispatcher runtime.MatchDispatcher,
 
 // It resets the game state, deals cards, and determines the starting player.
 
-func (m *Match) startNewGame(s *MatchState, dispatcher runtime.MatchDispatcher) error {
+func (m *Match) startNewGame(s *MatchState, dispatcher runtime.MatchDispatcher, logger runtime.Logger) error {
 
 	activePlayers := m.orderedSeatedPlayers(s)
 
@@ -222,11 +507,18 @@ func (m *Match) startNewGame(s *MatchState, dispatcher runtime.MatchDispatcher)
 
 	// Reinitialize game state for a new game session
 
-	s.Game = tienlen.NewGame()
+	s.Game = newGame(s.rules(), s.Config.DealConfig)
 
-	rand.Seed(time.Now().UnixNano())
+	// Every deal is committed so players can verify it once the seed is revealed at game over.
+	if s.NextServerSeed == "" {
+		if err := s.prepareDeal(); err != nil {
+			return err
+		}
+	}
+	commitment := tienlen.CommitDeal(s.NextServerSeed, s.clientEntropy(activePlayers))
+	logger.Info("Dealing new game with commitment %s", commitment.Commitment)
 
-	events, err := s.Game.Start(activePlayers, s.OwnerID, s.LastGameWinnerID)
+	events, err := s.Game.StartCommitted(commitment, activePlayers, s.OwnerID, s.LastGameWinnerID)
 
 	if err != nil {
 
@@ -234,22 +526,151 @@ func (m *Match) startNewGame(s *MatchState, dispatcher runtime.MatchDispatcher)
 
 	}
 
-	adapter.DispatchEvents(dispatcher, s.Presences, events)
+	if err := s.prepareDeal(); err != nil {
+		logger.Error("Failed to draw the next server seed: %v", err)
+	}
+	s.resetTimeBanks(activePlayers)
+	s.Ready = make(map[string]bool)
+	s.DealFailed = false
+	m.enterPhase(s, dispatcher, PhasePlaying, 0)
+
+	// A dealt hand may win on the spot, so the deal is dispatched like any move.
+	m.dispatchGameEvents(s, dispatcher, events)
+
+	return nil
+
+}
+
+// dispatchGameEvents broadcasts the events of a move, records the game's winner once known
+// and keeps the log of a game that has ended.
+func (m *Match) dispatchGameEvents(s *MatchState, dispatcher runtime.MatchDispatcher, events []tienlen.Event) {
+	if len(s.Game.Winners) != 0 {
+		s.LastGameWinnerID = s.Game.Winners[0]
+	}
+	if !s.Game.IsPlaying() {
+		s.LastGameLog = s.Game.Log()
+		s.GamesPlayed++
+		s.LogPending = true
+	}
+	adapter.DispatchEvents(dispatcher, s.Presences, events, s.restartTurnClock(events))
+	if !s.Game.IsPlaying() {
+		m.enterPhase(s, dispatcher, PhaseSettlement, settlementSeconds)
+	}
+}
+
+// --- Bots ---
 
+// addBot seats a bot in the first free seat. Only the owner may do so, and only between games.
+func (m *Match) addBot(s *MatchState, dispatcher runtime.MatchDispatcher, requesterID string, strategy string) error {
+	if requesterID != s.OwnerID {
+		return errors.New("only the owner can add bots")
+	}
+	if s.Game.IsPlaying() {
+		return errors.New("cannot change seats during a game")
+	}
+	slot := m.findOpenSeat(s)
+	if slot == -1 {
+		return errors.New("no free seat for a bot")
+	}
+	if strategy == "" {
+		strategy = bot.StrategyGreedy
+	}
+	b, err := bot.New(strategy, rand.New(rand.NewSource(newSeed())))
+	if err != nil {
+		return err
+	}
+
+	botID := fmt.Sprintf("bot-%d", slot+1)
+	s.Seats[slot] = Seat{UserID: botID, Bot: strategy}
+	s.SeatByUser[botID] = slot
+	s.Bots[botID] = b
+	adapter.BroadcastPlayerJoined(dispatcher, s.table(), s.Game)
+	return nil
+}
+
+// removeBot frees the seat held by a bot. Only the owner may do so, and only between games.
+func (m *Match) removeBot(s *MatchState, dispatcher runtime.MatchDispatcher, requesterID string, botID string) error {
+	if requesterID != s.OwnerID {
+		return errors.New("only the owner can remove bots")
+	}
+	if s.Game.IsPlaying() {
+		return errors.New("cannot change seats during a game")
+	}
+	if _, ok := s.Bots[botID]; !ok {
+		return errors.New("no such bot")
+	}
+	m.freeSeat(s, dispatcher, botID)
+	delete(s.Bots, botID)
+	adapter.BroadcastPlayerLeft(dispatcher, s.table(), s.Game)
 	return nil
+}
 
+// runBots lets a bot holding the turn move once its thinking delay has elapsed.
+// Bots act through Game.PlayCards and Game.Pass, exactly like human players.
+func (m *Match) runBots(s *MatchState, dispatcher runtime.MatchDispatcher, logger runtime.Logger, tick int64) {
+	if !s.Game.IsPlaying() {
+		s.BotTurnID = ""
+		return
+	}
+	active := s.Game.Snapshot().ActivePlayerID
+	b, ok := s.Bots[active]
+	if !ok {
+		s.BotTurnID = ""
+		return
+	}
+	if s.BotTurnID != active {
+		s.BotTurnID = active
+		s.BotActAtTick = tick + minBotDelayTicks + rand.Int63n(botDelayJitterTicks)
+		return
+	}
+	if tick < s.BotActAtTick {
+		return
+	}
+
+	s.BotTurnID = ""
+	events, err := bot.Act(s.Game, b, active)
+	if err != nil {
+		// Move as on a timeout instead, so a bot can never stall the table.
+		logger.Warn("Bot %s could not move, timing it out: %v", active, err)
+		if events, err = s.Game.Timeout(active); err != nil {
+			logger.Error("Could not time out bot %s: %v", active, err)
+			return
+		}
+	}
+	m.dispatchGameEvents(s, dispatcher, events)
 }
 
 // --- Helpers ---
 
+// newGame creates a game of the match, to be dealt with deal.
+func newGame(rules tienlen.RuleSet, deal tienlen.DealConfig) *tienlen.Game {
+	g := tienlen.NewGameWithRules(rules)
+	g.Deal = deal
+	return g
+}
+
+// rules returns the rule set for the match's variant, validated at MatchInit.
+func (s *MatchState) rules() tienlen.RuleSet {
+	rules, err := tienlen.RuleSetFor(s.Config.Variant)
+	if err != nil {
+		return tienlen.SouthernRules{}
+	}
+	return rules
+}
+
+// sendError shows msg to one player. Go errors are lowercase, so the message is capitalized
+// on the way out.
 func sendError(dispatcher runtime.MatchDispatcher, p runtime.Presence, msg string) {
+	if r, size := utf8.DecodeRuneInString(msg); r != utf8.RuneError {
+		msg = string(unicode.ToUpper(r)) + msg[size:]
+	}
 	data := []byte(msg)
 	dispatcher.BroadcastMessage(int64(pb.OpCode_OP_ERROR), data, []runtime.Presence{p}, nil, true)
 }
 
 func (m *Match) findOpenSeat(s *MatchState) int {
 	for i := 0; i < len(s.Seats); i++ {
-		if s.Seats[i] == "" {
+		if s.Seats[i].UserID == "" {
 			return i
 		}
 	}
@@ -266,9 +687,9 @@ func (m *Match) assignSeat(logger runtime.Logger, s *MatchState, dispatcher runt
 		logger.Warn("No free seat for user %s", userID)
 		return
 	}
-	s.Seats[slot] = userID
+	s.Seats[slot] = Seat{UserID: userID}
 	s.SeatByUser[userID] = slot
-	adapter.BroadcastPlayerJoined(dispatcher, seatsAsSlice(s.Seats), s.OwnerID, s.Game)
+	adapter.BroadcastPlayerJoined(dispatcher, s.table(), s.Game)
 }
 
 func (m *Match) freeSeat(s *MatchState, dispatcher runtime.MatchDispatcher, userID string) {
@@ -276,13 +697,15 @@ func (m *Match) freeSeat(s *MatchState, dispatcher runtime.MatchDispatcher, user
 	if !ok {
 		return
 	}
-	s.Seats[slot] = ""
+	s.Seats[slot] = Seat{}
 	delete(s.SeatByUser, userID)
+	delete(s.Ready, userID)
 }
 
 func (m *Match) orderedSeatedPlayers(s *MatchState) []string {
 	players := make([]string, 0, len(s.SeatByUser))
-	for _, uid := range s.Seats {
+	for _, seat := range s.Seats {
+		uid := seat.UserID
 		if uid == "" {
 			continue
 		}
@@ -294,8 +717,66 @@ func (m *Match) orderedSeatedPlayers(s *MatchState) []string {
 	return players
 }
 
-func seatsAsSlice(seats [4]string) []string {
-	out := make([]string, len(seats))
-	copy(out, seats[:])
-	return out
+// prepareDeal draws the server seed of the next deal. Drawing a seed discards all the entropy
+// collected so far, and the reveal at game over lists the entropy that went into the deal, so
+// a server that redraws seeds until it likes the deal shows up as missing entropy. Entropy
+// sent in join metadata arrives before the joiner has been sent the seed hash.
+func (s *MatchState) prepareDeal() error {
+	s.NextServerSeed = ""
+	s.ClientEntropy = make(map[string]string)
+	seed, err := newServerSeed()
+	if err != nil {
+		return err
+	}
+	s.NextServerSeed = seed
+	return nil
+}
+
+// addEntropy records entropy a player contributes to the next deal.
+func (s *MatchState) addEntropy(userID, entropy string) {
+	if entropy == "" {
+		return
+	}
+	if len(entropy) > maxEntropyLength {
+		entropy = entropy[:maxEntropyLength]
+	}
+	s.ClientEntropy[userID] = entropy
+}
+
+// clientEntropy combines the entropy contributed by the given players, in seat order.
+func (s *MatchState) clientEntropy(players []string) string {
+	parts := make([]string, 0, len(players))
+	for _, uid := range players {
+		if entropy := s.ClientEntropy[uid]; entropy != "" {
+			parts = append(parts, uid+"="+entropy)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// table describes the seating for state packets; bots are listed so clients can label them.
+func (s *MatchState) table() adapter.Table {
+	table := adapter.Table{
+		Seats:        make([]string, len(s.Seats)),
+		OwnerID:      s.OwnerID,
+		TurnDeadline: s.TurnDeadline,
+		TimeBanks:    s.timeBanksMs(),
+		Disconnected: s.disconnectedIDs(),
+
+		Phase:         string(s.Phase),
+		PhaseDeadline: s.PhaseDeadline,
+		Ready:         s.readyIDs(),
+
+		Config: s.Config.toPB(),
+	}
+	if s.NextServerSeed != "" {
+		table.NextSeedHash = tienlen.SeedHash(s.NextServerSeed)
+	}
+	for i, seat := range s.Seats {
+		table.Seats[i] = seat.UserID
+		if seat.IsBot() {
+			table.BotIDs = append(table.BotIDs, seat.UserID)
+		}
+	}
+	return table
 }
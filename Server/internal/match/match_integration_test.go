@@ -1,15 +1,32 @@
 package match
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/yourusername/tienlen-server/internal/bot"
+	"github.com/yourusername/tienlen-server/internal/match/adapter"
 	"github.com/yourusername/tienlen-server/internal/tienlen"
 	"github.com/yourusername/tienlen-server/pb"
 	"google.golang.org/protobuf/proto"
 )
 
+// TestMain pins the seeds the match draws for its own deals. Tests that start a game on
+// s.Game directly pass their own seed to StartWithSeed.
+func TestMain(m *testing.M) {
+	newServerSeed = func() (string, error) { return "test-seed", nil }
+	newSeed = func() int64 { return 1 }
+	os.Exit(m.Run())
+}
+
 type stubPresence struct{ id string }
 
 func (p stubPresence) GetHidden() bool                   { return false }
@@ -49,7 +66,8 @@ type recordedMessage struct {
 }
 
 type recordingDispatcher struct {
-	msgs []recordedMessage
+	msgs   []recordedMessage
+	labels []string
 }
 
 func (d *recordingDispatcher) BroadcastMessage(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence, reliable bool) error {
@@ -62,10 +80,31 @@ func (d *recordingDispatcher) BroadcastMessageDeferred(opCode int64, data []byte
 }
 
 func (d *recordingDispatcher) MatchKick(presences []runtime.Presence) error { return nil }
-func (d *recordingDispatcher) MatchLabelUpdate(label string) error          { return nil }
+func (d *recordingDispatcher) MatchLabelUpdate(label string) error {
+	d.labels = append(d.labels, label)
+	return nil
+}
 
 func (d *recordingDispatcher) reset() { d.msgs = nil }
 
+// storageRecorder records storage writes and deletes; every other call panics on the nil
+// module.
+type storageRecorder struct {
+	runtime.NakamaModule
+	writes  []*runtime.StorageWrite
+	deletes []*runtime.StorageDelete
+}
+
+func (n *storageRecorder) StorageWrite(ctx context.Context, writes []*runtime.StorageWrite) ([]*api.StorageObjectAck, error) {
+	n.writes = append(n.writes, writes...)
+	return nil, nil
+}
+
+func (n *storageRecorder) StorageDelete(ctx context.Context, deletes []*runtime.StorageDelete) error {
+	n.deletes = append(n.deletes, deletes...)
+	return nil
+}
+
 type testLogger struct{ t *testing.T }
 
 func (l testLogger) Debug(format string, v ...interface{}) {}
@@ -80,6 +119,45 @@ func (l testLogger) WithFields(fields map[string]interface{}) runtime.Logger {
 }
 func (l testLogger) Fields() map[string]interface{} { return nil }
 
+// newTestTable creates a match from params and seats the players in order, so the first
+// of them owns the table.
+func newTestTable(t *testing.T, params map[string]interface{}, players ...string) (*Match, *MatchState, *recordingDispatcher) {
+	m := &Match{}
+	dispatcher := &recordingDispatcher{}
+	state, _, _ := m.MatchInit(context.Background(), testLogger{t}, nil, nil, params)
+	if state == nil {
+		t.Fatalf("expected a match for params %v", params)
+	}
+	s := state.(*MatchState)
+	presences := make([]runtime.Presence, 0, len(players))
+	for _, id := range players {
+		presences = append(presences, stubPresence{id: id})
+	}
+	m.MatchJoin(context.Background(), testLogger{t}, nil, nil, dispatcher, 0, s, presences)
+	return m, s, dispatcher
+}
+
+// startGame deals at once through the owner's start request.
+func startGame(t *testing.T, m *Match, s *MatchState, dispatcher *recordingDispatcher) {
+	m.handleMessage(s, dispatcher, testLogger{t}, stubMatchData{op: int64(pb.OpCode_OP_GAME_START_REQUEST), userID: s.OwnerID})
+}
+
+// lastPacket decodes the last message sent with op into packet and returns it.
+func lastPacket[P proto.Message](t *testing.T, dispatcher *recordingDispatcher, op pb.OpCode, packet P) P {
+	t.Helper()
+	for i := len(dispatcher.msgs) - 1; i >= 0; i-- {
+		if pb.OpCode(dispatcher.msgs[i].op) != op {
+			continue
+		}
+		if err := proto.Unmarshal(dispatcher.msgs[i].data, packet); err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", op, err)
+		}
+		return packet
+	}
+	t.Fatalf("no %s sent", op)
+	return packet
+}
+
 func TestMatchStartFlowDispatchesMessages(t *testing.T) {
 	m := &Match{}
 	logger := testLogger{t}
@@ -94,8 +172,7 @@ func TestMatchStartFlowDispatchesMessages(t *testing.T) {
 	m.MatchJoin(context.Background(), logger, nil, nil, dispatcher, 0, s, []runtime.Presence{p1, p2})
 	dispatcher.reset()
 
-	startMsg := stubMatchData{op: int64(pb.OpCode_OP_GAME_START_REQUEST), userID: "p1"}
-	m.handleMessage(s, dispatcher, logger, startMsg)
+	startGame(t, m, s, dispatcher)
 
 	if len(dispatcher.msgs) == 0 {
 		t.Fatalf("expected messages after match start")
@@ -137,9 +214,12 @@ func TestMatchPlayAndPassFlow(t *testing.T) {
 	p2 := stubPresence{id: "p2"}
 	m.MatchJoin(context.Background(), logger, nil, nil, dispatcher, 0, s, []runtime.Presence{p1, p2})
 
-	// Prepare deterministic game state.
+	// Prepare deterministic game state. p1 won the last game, so no opening card is required.
+	// Seed 1 deals no instant win, so the game stays in progress.
 	s.Game = tienlen.NewGame()
-	_, _ = s.Game.Start([]string{"p1", "p2"}, "p1", "")
+	if _, err := s.Game.StartWithSeed(1, []string{"p1", "p2"}, "p1", "p1"); err != nil || !s.Game.IsPlaying() {
+		t.Fatalf("expected seed 1 to start a game in progress, got %v", err)
+	}
 	s.Game.Hands = map[string][]tienlen.Card{
 		"p1": {{Rank: 1, Suit: 0}, {Rank: 3, Suit: 0}},
 		"p2": {{Rank: 2, Suit: 0}, {Rank: 4, Suit: 0}},
@@ -212,8 +292,7 @@ func TestGameOverManagement(t *testing.T) {
 	dispatcher.reset()
 
 	// --- Simulate game start ---
-	startMsg := stubMatchData{op: int64(pb.OpCode_OP_GAME_START_REQUEST), userID: "p1"}
-	m.handleMessage(s, dispatcher, logger, startMsg)
+	startGame(t, m, s, dispatcher)
 	dispatcher.reset()
 
 	if !s.Game.IsPlaying() {
@@ -266,9 +345,16 @@ func TestGameOverManagement(t *testing.T) {
 		t.Fatalf("expected LastGameWinnerID to be p1, got %s", s.LastGameWinnerID)
 	}
 
-	// --- Simulate starting a new game ---
+	// --- The owner cannot deal while the results are up ---
 	startNewGameMsg := stubMatchData{op: int64(pb.OpCode_OP_GAME_START_REQUEST), userID: "p1"}
 	m.handleMessage(s, dispatcher, logger, startNewGameMsg)
+	if s.Game.IsPlaying() || pb.OpCode(dispatcher.msgs[len(dispatcher.msgs)-1].op) != pb.OpCode_OP_ERROR {
+		t.Fatal("expected no deal during the settlement")
+	}
+
+	// --- Simulate starting a new game ---
+	m.MatchLoop(context.Background(), logger, nil, nil, dispatcher, settlementSeconds*tickRate, s, nil)
+	startGame(t, m, s, dispatcher)
 	dispatcher.reset()
 
 	if !s.Game.IsPlaying() {
@@ -281,3 +367,1231 @@ func TestGameOverManagement(t *testing.T) {
 		t.Fatalf("expected LastGameWinnerID to still be p1, got %s", s.LastGameWinnerID)
 	}
 }
+
+func TestMatchInitSelectsVariantFromParams(t *testing.T) {
+	m := &Match{}
+	logger := testLogger{t}
+	dispatcher := &recordingDispatcher{}
+
+	state, _, _ := m.MatchInit(context.Background(), logger, nil, nil, map[string]interface{}{"variant": "northern"})
+	s := state.(*MatchState)
+	if s.Config.Variant != tienlen.VariantNorthern {
+		t.Fatalf("expected northern variant, got %q", s.Config.Variant)
+	}
+
+	m.MatchJoin(context.Background(), logger, nil, nil, dispatcher, 0, s, []runtime.Presence{stubPresence{id: "p1"}})
+
+	packet := lastPacket(t, dispatcher, pb.OpCode_OP_MATCH_STATE, &pb.MatchStatePacket{})
+	if packet.Variant != "northern" {
+		t.Fatalf("expected variant northern in match state, got %q", packet.Variant)
+	}
+
+	params := map[string]interface{}{"variant": "unknown", "private": true, "password": "hunter2"}
+	if state, _, _ = m.MatchInit(context.Background(), logger, nil, nil, params); state != nil {
+		t.Fatalf("expected an unknown variant to fail the match rather than open a public room, got %+v", state.(*MatchState).Config)
+	}
+}
+
+func TestInstantWinIsBroadcast(t *testing.T) {
+	dispatcher := &recordingDispatcher{}
+	hand := []tienlen.Card{{Rank: 12, Suit: 0}, {Rank: 12, Suit: 1}, {Rank: 12, Suit: 2}, {Rank: 12, Suit: 3}}
+
+	adapter.DispatchEvents(dispatcher, map[string]runtime.Presence{}, []tienlen.Event{
+		tienlen.InstantWin{PlayerID: "p2", Pattern: tienlen.InstantWinFourTwos, Hand: hand},
+		tienlen.GameOver{WinnerID: "p2"},
+	}, adapter.TurnClock{})
+
+	if len(dispatcher.msgs) != 2 || pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_INSTANT_WIN {
+		t.Fatalf("expected instant win followed by game over, got %+v", dispatcher.msgs)
+	}
+	packet := &pb.InstantWinPacket{}
+	if err := proto.Unmarshal(dispatcher.msgs[0].data, packet); err != nil {
+		t.Fatalf("failed to unmarshal InstantWinPacket: %v", err)
+	}
+	if packet.PlayerId != "p2" || packet.Pattern != "four_twos" || len(packet.Hand) != 4 {
+		t.Fatalf("unexpected instant win packet: %+v", packet)
+	}
+}
+
+func TestHintRequest(t *testing.T) {
+	logger := testLogger{t}
+
+	m, s, dispatcher := newTestTable(t, nil, "p1", "p2")
+	startGame(t, m, s, dispatcher)
+	dispatcher.reset()
+
+	active := s.Game.Snapshot().ActivePlayerID
+	m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_HINT_REQUEST), userID: active})
+	if len(dispatcher.msgs) != 1 || pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_HINT {
+		t.Fatalf("expected a single hint response, got %+v", dispatcher.msgs)
+	}
+	packet := &pb.HintPacket{}
+	if err := proto.Unmarshal(dispatcher.msgs[0].data, packet); err != nil {
+		t.Fatalf("failed to unmarshal HintPacket: %v", err)
+	}
+	if len(packet.Moves) == 0 || len(packet.Moves) > maxHints {
+		t.Fatalf("expected between 1 and %d hints, got %d", maxHints, len(packet.Moves))
+	}
+	hand := s.Game.HandOf(active)
+	for _, move := range packet.Moves {
+		for i, idx := range move.CardIndices {
+			if c := hand[idx]; c.Rank != move.Cards[i].Rank || c.Suit != move.Cards[i].Suit {
+				t.Fatalf("hint index %d does not match card %v", idx, move.Cards[i])
+			}
+		}
+	}
+
+	dispatcher.reset()
+	s.Config.HintsAllowed = false
+	m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_HINT_REQUEST), userID: active})
+	if len(dispatcher.msgs) != 1 || pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_ERROR {
+		t.Fatalf("expected an error when hints are disabled, got %+v", dispatcher.msgs)
+	}
+}
+
+func TestBotsFillSeatsAndPlay(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	m, s, dispatcher := newTestTable(t, nil, "p1", "p2")
+
+	addBot := func(sender, strategy string) {
+		data, _ := proto.Marshal(&pb.AddBotRequest{Strategy: strategy})
+		m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_ADD_BOT), userID: sender, data: data})
+	}
+	removeBot := func(sender, botID string) {
+		data, _ := proto.Marshal(&pb.RemoveBotRequest{BotId: botID})
+		m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_REMOVE_BOT), userID: sender, data: data})
+	}
+	lastOp := func() pb.OpCode { return pb.OpCode(dispatcher.msgs[len(dispatcher.msgs)-1].op) }
+
+	dispatcher.reset()
+	addBot("p2", "")
+	if lastOp() != pb.OpCode_OP_ERROR || len(s.Bots) != 0 {
+		t.Fatalf("expected only the owner to add bots")
+	}
+	addBot("p1", "genius")
+	if lastOp() != pb.OpCode_OP_ERROR || len(s.Bots) != 0 {
+		t.Fatalf("expected an unknown strategy to be rejected")
+	}
+
+	addBot("p1", "")
+	addBot("p1", "random")
+	if len(s.Bots) != 2 || !s.Seats[2].IsBot() || !s.Seats[3].IsBot() {
+		t.Fatalf("expected bots in seats 2 and 3, got %+v", s.Seats)
+	}
+	packet := lastPacket(t, dispatcher, pb.OpCode_OP_MATCH_STATE, &pb.MatchStatePacket{})
+	if len(packet.BotIds) != 2 || packet.PlayerIds[2] != packet.BotIds[0] {
+		t.Fatalf("expected the bots listed in the match state, got %+v", packet)
+	}
+	addBot("p1", "")
+	if lastOp() != pb.OpCode_OP_ERROR {
+		t.Fatalf("expected an error when no seat is free")
+	}
+
+	startGame(t, m, s, dispatcher)
+	if len(s.Game.TurnOrder) != 4 {
+		t.Fatalf("expected bots to be dealt in, got turn order %v", s.Game.TurnOrder)
+	}
+	removeBot("p1", "bot-3")
+	if lastOp() != pb.OpCode_OP_ERROR || len(s.Bots) != 2 {
+		t.Fatalf("expected bots to stay seated during a game")
+	}
+
+	// Humans pass whenever they can and otherwise play their best hint; bots act on ticks.
+	dispatcher.reset()
+	var tick int64
+	for tick = 1; s.Game.IsPlaying() && tick < 5000; tick++ {
+		var messages []runtime.MatchData
+		active := s.Game.Snapshot().ActivePlayerID
+		if _, isBot := s.Bots[active]; !isBot {
+			hints, canPass, err := s.Game.Hints(active, 1)
+			if err != nil {
+				t.Fatalf("hints for %s: %v", active, err)
+			}
+			if canPass {
+				messages = append(messages, stubMatchData{op: int64(pb.OpCode_OP_PASS), userID: active})
+			} else {
+				req := &pb.PlayCardRequest{}
+				for _, idx := range hints[0].Indices {
+					req.CardIndices = append(req.CardIndices, int32(idx))
+				}
+				data, _ := proto.Marshal(req)
+				messages = append(messages, stubMatchData{op: int64(pb.OpCode_OP_PLAY_CARD), userID: active, data: data})
+			}
+		}
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, messages)
+	}
+	if s.Game.IsPlaying() {
+		t.Fatalf("expected the game to finish, still playing after %d ticks", tick)
+	}
+	for _, msg := range dispatcher.msgs {
+		if pb.OpCode(msg.op) == pb.OpCode_OP_ERROR {
+			t.Fatalf("unexpected error during play: %s", msg.data)
+		}
+	}
+	// The game over is followed by the match state of the settlement phase.
+	if op := pb.OpCode(dispatcher.msgs[len(dispatcher.msgs)-2].op); op != pb.OpCode_OP_GAME_OVER || lastOp() != pb.OpCode_OP_MATCH_STATE || s.Phase != PhaseSettlement {
+		t.Fatalf("expected the game to end with game over and settlement, got %v then %v", op, lastOp())
+	}
+
+	_, encoded := m.MatchSignal(ctx, logger, nil, nil, dispatcher, tick, s, "last_game_log")
+	var log []tienlen.LogEntry
+	if err := json.Unmarshal([]byte(encoded), &log); err != nil {
+		t.Fatalf("failed to decode game log %q: %v", encoded, err)
+	}
+	replayed, err := tienlen.Replay(log)
+	if err != nil {
+		t.Fatalf("Replay error: %v", err)
+	}
+	if want, got := s.Game.State(), replayed.State(); !reflect.DeepEqual(want, got) {
+		t.Fatalf("replayed game differs:\nwant %+v\ngot  %+v", want, got)
+	}
+
+	removeBot("p1", "bot-3")
+	if len(s.Bots) != 1 || s.Seats[2].UserID != "" {
+		t.Fatalf("expected bot-3 removed after the game, got %+v", s.Seats)
+	}
+}
+
+// illegalBot always tries to play a card it does not hold.
+type illegalBot struct{}
+
+func (illegalBot) Decide(view bot.View) bot.Decision {
+	return bot.Decision{Cards: []tienlen.Card{{Rank: 99}}}
+}
+
+func TestBotWithIllegalMoveDoesNotStallTable(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	m, s, dispatcher := newTestTable(t, nil, "p1")
+	data, _ := proto.Marshal(&pb.AddBotRequest{})
+	m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_ADD_BOT), userID: "p1", data: data})
+	startGame(t, m, s, dispatcher)
+	for id := range s.Bots {
+		s.Bots[id] = illegalBot{}
+	}
+
+	// The bot leads a round at least once before the game ends; it must never hold up play.
+	var tick int64
+	for tick = 1; s.Game.IsPlaying() && tick < 5000; tick++ {
+		var messages []runtime.MatchData
+		if active := s.Game.Snapshot().ActivePlayerID; active == "p1" {
+			hints, canPass, _ := s.Game.Hints(active, 1)
+			if canPass {
+				messages = append(messages, stubMatchData{op: int64(pb.OpCode_OP_PASS), userID: active})
+			} else {
+				req := &pb.PlayCardRequest{}
+				for _, idx := range hints[0].Indices {
+					req.CardIndices = append(req.CardIndices, int32(idx))
+				}
+				data, _ := proto.Marshal(req)
+				messages = append(messages, stubMatchData{op: int64(pb.OpCode_OP_PLAY_CARD), userID: active, data: data})
+			}
+		}
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, messages)
+	}
+	if s.Game.IsPlaying() {
+		t.Fatalf("expected the game to finish, still playing after %d ticks", tick)
+	}
+}
+
+func TestDealCommitmentPublishedAtStart(t *testing.T) {
+	m := &Match{}
+	logger := testLogger{t}
+	dispatcher := &recordingDispatcher{}
+	ctx := context.Background()
+
+	state, _, _ := m.MatchInit(ctx, logger, nil, nil, nil)
+	s := state.(*MatchState)
+	p1, p2 := stubPresence{id: "p1"}, stubPresence{id: "p2"}
+	m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 0, s, p1, map[string]string{"entropy": "lucky"})
+	m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 0, s, p2, nil)
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 0, s, []runtime.Presence{p1, p2})
+
+	// The seed of the deal is committed to before any entropy is taken.
+	seedHash := lastPacket(t, dispatcher, pb.OpCode_OP_MATCH_STATE, &pb.MatchStatePacket{}).NextSeedHash
+	if seedHash != tienlen.SeedHash("test-seed") {
+		t.Fatalf("expected the hash of the next server seed in the match state, got %q", seedHash)
+	}
+	ready, _ := proto.Marshal(&pb.ReadyRequest{Entropy: "charm"})
+	m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_READY), userID: "p2", data: ready})
+	dispatcher.reset()
+
+	m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_GAME_START_REQUEST), userID: "p1"})
+	if len(s.ClientEntropy) != 0 || s.NextServerSeed == "" {
+		t.Fatalf("expected a fresh seed for the next deal without entropy, got %q, %v", s.NextServerSeed, s.ClientEntropy)
+	}
+
+	hands := make(map[string][]tienlen.Card)
+	var commitment string
+	for _, msg := range dispatcher.msgs {
+		if pb.OpCode(msg.op) != pb.OpCode_OP_GAME_START {
+			continue
+		}
+		packet := &pb.MatchStartPacket{}
+		if err := proto.Unmarshal(msg.data, packet); err != nil {
+			t.Fatalf("failed to unmarshal MatchStartPacket: %v", err)
+		}
+		if packet.DealCommitment == "" || packet.ClientEntropy != "p1=lucky,p2=charm" {
+			t.Fatalf("expected the commitment and entropy in the start packet, got %+v", packet)
+		}
+		commitment = packet.DealCommitment
+		for _, c := range packet.Hand {
+			hands[msg.presences[0].GetUserId()] = append(hands[msg.presences[0].GetUserId()], tienlen.Card{Suit: c.Suit, Rank: c.Rank})
+		}
+	}
+
+	// End the game on the next play, then check the revealed deal against what each player was dealt.
+	for uid, hand := range s.Game.Hands {
+		s.Game.Hands[uid] = hand[:1]
+	}
+	active := s.Game.Snapshot().ActivePlayerID
+	data, _ := proto.Marshal(&pb.PlayCardRequest{CardIndices: []int32{0}})
+	dispatcher.reset()
+	m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_PLAY_CARD), userID: active, data: data})
+
+	last := dispatcher.msgs[len(dispatcher.msgs)-2]
+	packet := &pb.GameOverPacket{}
+	if pb.OpCode(last.op) != pb.OpCode_OP_GAME_OVER || proto.Unmarshal(last.data, packet) != nil || packet.Deal == nil {
+		t.Fatalf("expected a game over revealing the deal, got %+v", dispatcher.msgs)
+	}
+	reveal := tienlen.DealReveal{
+		DealCommitment: tienlen.DealCommitment{
+			ServerSeed:    packet.Deal.ServerSeed,
+			SeedHash:      packet.Deal.SeedHash,
+			ClientEntropy: packet.Deal.ClientEntropy,
+			Commitment:    packet.Deal.Commitment,
+		},
+		Players:   packet.Deal.PlayerIds,
+		HandSize:  int(packet.Deal.HandSize),
+		Direction: tienlen.Direction(packet.Deal.Direction),
+	}
+	for _, c := range packet.Deal.Deck {
+		reveal.Deck = append(reveal.Deck, tienlen.Card{Suit: c.Suit, Rank: c.Rank})
+	}
+	if reveal.Commitment != commitment || reveal.SeedHash != seedHash {
+		t.Fatalf("revealed commitment %q differs from the published %q", reveal.Commitment, commitment)
+	}
+	if err := tienlen.VerifyDeal(reveal, hands); err != nil {
+		t.Fatalf("VerifyDeal rejected the revealed deal: %v", err)
+	}
+}
+
+func TestPlayCardsByValue(t *testing.T) {
+	logger := testLogger{t}
+
+	m, s, dispatcher := newTestTable(t, nil, "p1", "p2")
+	startGame(t, m, s, dispatcher)
+
+	active := s.Game.Snapshot().ActivePlayerID
+	card := s.Game.HandOf(active)[0]
+	play := func(req *pb.PlayCardRequest) {
+		dispatcher.reset()
+		data, _ := proto.Marshal(req)
+		m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_PLAY_CARD), userID: active, data: data})
+	}
+
+	// A stale hand version is refused rather than playing whatever sits at index 0 now.
+	play(&pb.PlayCardRequest{CardIndices: []int32{0}, HandVersion: tienlen.InitialHandVersion + 1})
+	if len(dispatcher.msgs) != 1 || pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_ERROR {
+		t.Fatalf("expected an error for a stale hand version, got %+v", dispatcher.msgs)
+	}
+
+	play(&pb.PlayCardRequest{Cards: []*pb.Card{{Suit: card.Suit, Rank: card.Rank}}})
+	update := &pb.HandUpdatePacket{}
+	if pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_HAND_UPDATE || proto.Unmarshal(dispatcher.msgs[0].data, update) != nil {
+		t.Fatalf("expected a hand update after playing by value, got %+v", dispatcher.msgs)
+	}
+	if len(update.Hand) != 12 || update.Version != tienlen.InitialHandVersion+1 {
+		t.Fatalf("expected 12 cards at version %d, got %d at %d", tienlen.InitialHandVersion+1, len(update.Hand), update.Version)
+	}
+	for _, c := range update.Hand {
+		if c.Suit == card.Suit && c.Rank == card.Rank {
+			t.Fatalf("played card %v still in hand", card)
+		}
+	}
+}
+
+func TestGameStateProtoRoundTrip(t *testing.T) {
+	m, s, dispatcher := newTestTable(t, nil, "p1", "p2")
+	startGame(t, m, s, dispatcher)
+	active := s.Game.Snapshot().ActivePlayerID
+	if _, err := s.Game.PlayCards(active, []int{0}); err != nil {
+		t.Fatalf("PlayCards error: %v", err)
+	}
+
+	data, err := proto.Marshal(adapter.ToPBGameState(s.Game.State()))
+	if err != nil {
+		t.Fatalf("failed to marshal GameState: %v", err)
+	}
+	decoded := &pb.GameState{}
+	if err := proto.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("failed to unmarshal GameState: %v", err)
+	}
+	restored, err := tienlen.RestoreGame(adapter.FromPBGameState(decoded))
+	if err != nil {
+		t.Fatalf("RestoreGame error: %v", err)
+	}
+	if want, got := s.Game.State(), restored.State(); !reflect.DeepEqual(want, got) {
+		t.Fatalf("state changed across protobuf:\nwant %+v\ngot  %+v", want, got)
+	}
+}
+
+func TestMatchStateJSONRoundTripKeepsBots(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	m, s, dispatcher := newTestTable(t, nil, "p1")
+	data, _ := proto.Marshal(&pb.AddBotRequest{Strategy: "random"})
+	m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_ADD_BOT), userID: "p1", data: data})
+	startGame(t, m, s, dispatcher)
+	if active := s.Game.Snapshot().ActivePlayerID; active == "p1" {
+		if _, err := s.Game.PlayCards(active, []int{0}); err != nil {
+			t.Fatalf("PlayCards error: %v", err)
+		}
+	}
+
+	encoded, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("failed to marshal MatchState: %v", err)
+	}
+	restored := &MatchState{}
+	if err := json.Unmarshal(encoded, restored); err != nil {
+		t.Fatalf("failed to unmarshal MatchState: %v", err)
+	}
+	if _, ok := restored.Bots["bot-2"]; !ok || len(restored.Bots) != 1 {
+		t.Fatalf("expected the bot to be rebuilt from its seat, got %v", restored.Bots)
+	}
+
+	// Only the restored bot itself moves its turn on.
+	restored.Presences["p1"] = stubPresence{id: "p1"}
+	for tick := int64(1); tick < 100 && restored.Game.IsPlaying() && restored.Game.Snapshot().ActivePlayerID == "bot-2"; tick++ {
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, restored, nil)
+	}
+	if restored.Game.IsPlaying() && restored.Game.Snapshot().ActivePlayerID == "bot-2" {
+		t.Fatalf("expected the restored bot to move")
+	}
+}
+
+func TestGameLogSavedAtGameOver(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.WithValue(context.Background(), runtime.RUNTIME_CTX_MATCH_ID, "match-1.node")
+	nk := &storageRecorder{}
+
+	m, s, dispatcher := newTestTable(t, nil, "p1", "p2")
+	startGame(t, m, s, dispatcher)
+	m.MatchLoop(ctx, logger, nil, nk, dispatcher, 1, s, nil)
+	if len(nk.writes) != 0 {
+		t.Fatalf("expected nothing saved during the game, got %d writes", len(nk.writes))
+	}
+
+	// p1 goes out with the opening card, which ends the game.
+	opening, _ := s.Game.OpeningCard()
+	s.Game.Hands["p1"] = []tienlen.Card{opening}
+	s.Game.TurnOrder = []string{"p1", "p2"}
+	s.Game.CurrentIdx = 0
+	play, _ := proto.Marshal(&pb.PlayCardRequest{Cards: []*pb.Card{{Suit: opening.Suit, Rank: opening.Rank}}})
+	m.MatchLoop(ctx, logger, nil, nk, dispatcher, 2, s, []runtime.MatchData{stubMatchData{op: int64(pb.OpCode_OP_PLAY_CARD), userID: "p1", data: play}})
+	m.MatchLoop(ctx, logger, nil, nk, dispatcher, 3, s, nil)
+
+	if len(nk.writes) != 1 {
+		t.Fatalf("expected the log to be saved once, got %d writes", len(nk.writes))
+	}
+	w := nk.writes[0]
+	if w.Collection != gameLogCollection || w.Key != "match-1.node/1" || w.PermissionRead != 0 {
+		t.Fatalf("expected a hidden log keyed by match and game, got %+v", w)
+	}
+	var stored storedGameLog
+	if err := json.Unmarshal([]byte(w.Value), &stored); err != nil {
+		t.Fatalf("failed to decode the saved log: %v", err)
+	}
+	if stored.MatchID != "match-1.node" || stored.Game != 1 || !reflect.DeepEqual(stored.Log, s.Game.Log()) {
+		t.Fatalf("expected the finished game's log, got %+v", stored)
+	}
+}
+
+func TestSmallTableDealFromParams(t *testing.T) {
+	// Numbers in the create_match payload arrive decoded from JSON.
+	m, s, dispatcher := newTestTable(t, map[string]interface{}{"hand_size": float64(20), "seats": float64(2)}, "p1", "p2")
+	dispatcher.reset()
+	startGame(t, m, s, dispatcher)
+
+	var starts int
+	for _, msg := range dispatcher.msgs {
+		if pb.OpCode(msg.op) != pb.OpCode_OP_GAME_START {
+			continue
+		}
+		starts++
+		packet := &pb.MatchStartPacket{}
+		if err := proto.Unmarshal(msg.data, packet); err != nil {
+			t.Fatalf("failed to unmarshal MatchStartPacket: %v", err)
+		}
+		if len(packet.Hand) != 20 || packet.HandSize != 20 || packet.OpeningCard == nil {
+			t.Fatalf("expected 20 cards and an opening card, got %d cards, %+v", len(packet.Hand), packet)
+		}
+	}
+	if starts != 2 {
+		t.Fatalf("expected a start packet per player, got %d", starts)
+	}
+	if snap := s.Game.Snapshot(); snap.PlayerCount != 2 || snap.HandSize != 20 {
+		t.Fatalf("expected the small table in the snapshot, got %+v", snap)
+	}
+}
+
+func TestLargeTableSeats(t *testing.T) {
+	params := map[string]interface{}{"seats": float64(6), "max_spectators": float64(0)}
+	m, s, dispatcher := newTestTable(t, params, "p1", "p2", "p3", "p4", "p5", "p6")
+	if _, ok, _ := m.MatchJoinAttempt(context.Background(), testLogger{t}, nil, nil, dispatcher, 0, s, stubPresence{id: "p7"}, nil); ok {
+		t.Fatalf("expected a full six-seat table to reject a seventh player")
+	}
+
+	packet := lastPacket(t, dispatcher, pb.OpCode_OP_MATCH_STATE, &pb.MatchStatePacket{})
+	if packet.SeatCount != 6 || len(packet.PlayerIds) != 6 || packet.PlayerIds[5] != "p6" {
+		t.Fatalf("expected six seats in the match state, got %+v", packet)
+	}
+
+	startGame(t, m, s, dispatcher)
+	if len(s.Game.TurnOrder) != 6 || len(s.Game.Deck) != 104 {
+		t.Fatalf("expected six players dealt from two decks, got %d players and %d cards", len(s.Game.TurnOrder), len(s.Game.Deck))
+	}
+}
+
+func TestTurnClockTimesOutIdlePlayer(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	m, s, dispatcher := newTestTable(t, map[string]interface{}{"turn_seconds": float64(2)}, "p1", "p2")
+	dispatcher.reset()
+	startGame(t, m, s, dispatcher)
+
+	first := lastPacket(t, dispatcher, pb.OpCode_OP_TURN_UPDATE, &pb.TurnUpdatePacket{})
+	if first.SecondsRemaining != 2 || first.DeadlineUnixMs <= 0 {
+		t.Fatalf("expected a 2 second turn clock, got %+v", first)
+	}
+
+	// Nobody moves: the clock runs out after 2 seconds of ticks and the leader's lowest card is played.
+	leader := s.Game.Snapshot().ActivePlayerID
+	lowest := s.Game.HandOf(leader)[0]
+	dispatcher.reset()
+	for tick := int64(1); tick < 2*tickRate; tick++ {
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, nil)
+	}
+	if len(dispatcher.msgs) != 0 {
+		t.Fatalf("expected no timeout before the deadline, got %d messages", len(dispatcher.msgs))
+	}
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, 2*tickRate, s, nil)
+
+	if len(dispatcher.msgs) == 0 || pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_TURN_TIMED_OUT {
+		t.Fatalf("expected a timeout to be broadcast, got %+v", dispatcher.msgs)
+	}
+	timedOut := &pb.TurnTimedOutPacket{}
+	if err := proto.Unmarshal(dispatcher.msgs[0].data, timedOut); err != nil {
+		t.Fatalf("failed to unmarshal TurnTimedOutPacket: %v", err)
+	}
+	if timedOut.PlayerId != leader || len(timedOut.Cards) != 1 || timedOut.Cards[0].Rank != lowest.Rank || timedOut.Cards[0].Suit != lowest.Suit {
+		t.Fatalf("expected %s to lead %v on timeout, got %+v", leader, lowest, timedOut)
+	}
+	next := lastPacket(t, dispatcher, pb.OpCode_OP_TURN_UPDATE, &pb.TurnUpdatePacket{})
+	if next.ActivePlayerId == leader || next.SecondsRemaining != 2 || s.TurnDeadlineTick != 4*tickRate {
+		t.Fatalf("expected a fresh clock for the next player, got %+v (deadline tick %d)", next, s.TurnDeadlineTick)
+	}
+	if log := s.Game.Log(); log[len(log)-1].Kind != tienlen.CommandTimeout {
+		t.Fatalf("expected the timeout in the game log, got %+v", log[len(log)-1])
+	}
+}
+
+func TestTimeBankRunsOutAndForfeitsTurns(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	params := map[string]interface{}{"turn_seconds": float64(0), "time_bank_seconds": float64(3), "increment_seconds": float64(1)}
+	m, s, dispatcher := newTestTable(t, params, "p1", "p2")
+	startGame(t, m, s, dispatcher)
+
+	playHint := func(tick int64, playerID string) {
+		hints, _, err := s.Game.Hints(playerID, 1)
+		if err != nil || len(hints) == 0 {
+			t.Fatalf("no hint for %s: %v", playerID, err)
+		}
+		req := &pb.PlayCardRequest{}
+		for _, c := range hints[0].Cards {
+			req.Cards = append(req.Cards, &pb.Card{Suit: c.Suit, Rank: c.Rank})
+		}
+		data, _ := proto.Marshal(req)
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, []runtime.MatchData{
+			stubMatchData{op: int64(pb.OpCode_OP_PLAY_CARD), userID: playerID, data: data},
+		})
+	}
+
+	leader := s.Game.Snapshot().ActivePlayerID
+	other := "p1"
+	if leader == "p1" {
+		other = "p2"
+	}
+	if banks := lastPacket(t, dispatcher, pb.OpCode_OP_TURN_UPDATE, &pb.TurnUpdatePacket{}).TimeBankMs; banks["p1"] != 3000 || banks["p2"] != 3000 {
+		t.Fatalf("expected full 3 second banks, got %v", banks)
+	}
+
+	// The leader spends one second and earns it back as the increment.
+	playHint(10, leader)
+	update := lastPacket(t, dispatcher, pb.OpCode_OP_TURN_UPDATE, &pb.TurnUpdatePacket{})
+	if update.ActivePlayerId != other || update.TimeBankMs[leader] != 3000 || update.SecondsRemaining != 3 {
+		t.Fatalf("expected %s to keep a 3 second bank and %s to have 3 seconds, got %+v", leader, other, update)
+	}
+
+	// The other player idles until their bank is gone.
+	dispatcher.reset()
+	for tick := int64(11); tick <= 40; tick++ {
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, nil)
+	}
+	if pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_TURN_TIMED_OUT {
+		t.Fatalf("expected %s to time out when the bank ran out, got %+v", other, dispatcher.msgs)
+	}
+	if banks := lastPacket(t, dispatcher, pb.OpCode_OP_TURN_UPDATE, &pb.TurnUpdatePacket{}).TimeBankMs; banks[other] != 0 {
+		t.Fatalf("expected an empty bank for %s, got %v", other, banks)
+	}
+
+	// From now on every turn of theirs is forfeited at once.
+	dispatcher.reset()
+	playHint(41, s.Game.Snapshot().ActivePlayerID)
+	var timedOut bool
+	for _, msg := range dispatcher.msgs {
+		if pb.OpCode(msg.op) == pb.OpCode_OP_TURN_TIMED_OUT {
+			timedOut = true
+		}
+	}
+	if s.Game.IsPlaying() && !timedOut {
+		t.Fatalf("expected %s to be timed out on the same tick", other)
+	}
+
+	// A reconnecting player sees the banks in the match state.
+	dispatcher.reset()
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 42, s, []runtime.Presence{stubPresence{id: "p3"}})
+	packet := lastPacket(t, dispatcher, pb.OpCode_OP_MATCH_STATE, &pb.MatchStatePacket{})
+	if packet.TimeBankMs == nil || packet.TimeBankMs[other] != 0 {
+		t.Fatalf("expected the time banks in the match state, got %v", packet.TimeBankMs)
+	}
+}
+
+func TestDisconnectedPlayerKeepsSeatAndReconnects(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	// The grace outlasts the turn clock, so the leader's turn runs out while their seat is held.
+	grace := int64(defaultTurnSeconds + 10)
+	params := map[string]interface{}{"turn_seconds": float64(0), "disconnect_grace_seconds": float64(grace), "seats": float64(3), "max_spectators": float64(0)}
+	m, s, dispatcher := newTestTable(t, params, "p1", "p2", "p3")
+	startGame(t, m, s, dispatcher)
+
+	leader := s.Game.Snapshot().ActivePlayerID
+	hand := s.Game.HandOf(leader)
+	seat := s.SeatByUser[leader]
+
+	// The leader drops out: their seat is held and everyone sees them as disconnected.
+	dispatcher.reset()
+	if m.MatchLeave(ctx, logger, nil, nil, dispatcher, 0, s, []runtime.Presence{stubPresence{id: leader}}) == nil {
+		t.Fatalf("expected the match to survive a disconnect")
+	}
+	if got, ok := s.SeatByUser[leader]; !ok || got != seat || s.Seats[seat].UserID != leader {
+		t.Fatalf("expected seat %d to be held for %s, got %+v", seat, leader, s.Seats)
+	}
+	packet := lastPacket(t, dispatcher, pb.OpCode_OP_MATCH_STATE, &pb.MatchStatePacket{})
+	if !reflect.DeepEqual(packet.DisconnectedIds, []string{leader}) {
+		t.Fatalf("expected %s to be shown as disconnected, got %v", leader, packet.DisconnectedIds)
+	}
+	if s.TurnPlayerID != leader || s.TurnDeadlineTick != defaultTurnSeconds*tickRate {
+		t.Fatalf("expected a turn clock for the disconnected leader, got %s until %d", s.TurnPlayerID, s.TurnDeadlineTick)
+	}
+
+	// The held seat cannot be taken, but its owner may come back.
+	if _, ok, _ := m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 0, s, stubPresence{id: "p4"}, nil); ok {
+		t.Fatalf("expected a stranger to be refused the held seat")
+	}
+	if _, ok, reason := m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 0, s, stubPresence{id: leader}, nil); !ok {
+		t.Fatalf("expected %s to be let back in, got %q", leader, reason)
+	}
+
+	// During the grace the turn clock resolves the leader's turn.
+	dispatcher.reset()
+	for tick := int64(1); tick < defaultTurnSeconds*tickRate; tick++ {
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, nil)
+	}
+	if len(dispatcher.msgs) != 0 {
+		t.Fatalf("expected no timeout before the turn clock runs out, got %d messages", len(dispatcher.msgs))
+	}
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, defaultTurnSeconds*tickRate, s, nil)
+	if len(dispatcher.msgs) == 0 || pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_TURN_TIMED_OUT {
+		t.Fatalf("expected the leader's turn to time out, got %+v", dispatcher.msgs)
+	}
+	if s.TurnPlayerID != "" || s.Seats[seat].IsBot() {
+		t.Fatalf("expected no turn clock for a connected player and the seat still held, got %s, %+v", s.TurnPlayerID, s.Seats[seat])
+	}
+
+	// Reconnecting within the grace restores the seat, the state and the remaining hand.
+	dispatcher.reset()
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, grace*tickRate-1, s, []runtime.Presence{stubPresence{id: leader}})
+	if len(s.Disconnected) != 0 || s.SeatByUser[leader] != seat {
+		t.Fatalf("expected %s back in seat %d, disconnected: %v", leader, seat, s.Disconnected)
+	}
+	var gotHand *pb.HandUpdatePacket
+	for _, msg := range dispatcher.msgs {
+		if pb.OpCode(msg.op) == pb.OpCode_OP_HAND_UPDATE {
+			gotHand = &pb.HandUpdatePacket{}
+			if err := proto.Unmarshal(msg.data, gotHand); err != nil {
+				t.Fatalf("failed to unmarshal HandUpdatePacket: %v", err)
+			}
+		}
+	}
+	if gotHand == nil || len(gotHand.Hand) != len(hand)-1 {
+		t.Fatalf("expected %s to get back a hand of %d cards, got %+v", leader, len(hand)-1, gotHand)
+	}
+}
+
+func TestFinishedPlayerKeepsSeatAfterGrace(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	params := map[string]interface{}{"turn_seconds": float64(0), "disconnect_grace_seconds": float64(1), "seats": float64(3)}
+	m, s, dispatcher := newTestTable(t, params, "p1", "p2", "p3")
+	startGame(t, m, s, dispatcher)
+	if !s.Game.IsPlaying() {
+		t.Fatalf("expected a game in progress")
+	}
+	// p2 has gone out, so only p1 and p3 still play.
+	s.Game.FinishedPlayers["p2"] = true
+	m.MatchLeave(ctx, logger, nil, nil, dispatcher, 0, s, []runtime.Presence{stubPresence{id: "p2"}})
+
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, tickRate, s, nil)
+	seat := s.Seats[s.SeatByUser["p2"]]
+	if seat.IsBot() || s.Game.Abandoned("p2") || len(s.Bots) != 0 {
+		t.Fatalf("expected no bot for a player who already went out, got seat %+v", seat)
+	}
+	if _, held := s.Disconnected["p2"]; !held {
+		t.Fatalf("expected p2's seat to stay held until the game is over")
+	}
+}
+
+func TestBotTakesOverAbandonedSeat(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	params := map[string]interface{}{"turn_seconds": float64(0), "disconnect_grace_seconds": float64(1)}
+	m, s, dispatcher := newTestTable(t, params, "p1", "p2")
+	startGame(t, m, s, dispatcher)
+	m.MatchLeave(ctx, logger, nil, nil, dispatcher, 0, s, []runtime.Presence{stubPresence{id: "p2"}})
+
+	// After the grace a bot plays p2's hand under p2's seat.
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, tickRate, s, nil)
+	seat := s.Seats[s.SeatByUser["p2"]]
+	if !seat.IsBot() || !seat.TakenOver || !s.Game.Abandoned("p2") || len(s.Disconnected) != 0 {
+		t.Fatalf("expected a bot to take over p2, got seat %+v", seat)
+	}
+	if log := s.Game.Log(); log[len(log)-1].Kind != tienlen.CommandLeave {
+		t.Fatalf("expected the leave in the game log, got %+v", log[len(log)-1])
+	}
+
+	// p2 comes back as a spectator and cannot move for the bot.
+	dispatcher.reset()
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, tickRate, s, []runtime.Presence{stubPresence{id: "p2"}})
+	if !s.Spectators["p2"] {
+		t.Fatalf("expected p2 to rejoin as a spectator")
+	}
+	m.handleMessage(s, dispatcher, logger, stubMatchData{op: int64(pb.OpCode_OP_PASS), userID: "p2"})
+	if last := dispatcher.msgs[len(dispatcher.msgs)-1]; pb.OpCode(last.op) != pb.OpCode_OP_ERROR {
+		t.Fatalf("expected p2's move to be rejected, got op %d", last.op)
+	}
+
+	// p1 plays every hint until the game ends; the bot answers for p2.
+	var gameOver *pb.GameOverPacket
+	for tick := int64(tickRate + 1); tick < 10000 && s.Game.IsPlaying(); tick++ {
+		var messages []runtime.MatchData
+		if s.Game.Snapshot().ActivePlayerID == "p1" {
+			hints, canPass, _ := s.Game.Hints("p1", 1)
+			if len(hints) > 0 {
+				req := &pb.PlayCardRequest{}
+				for _, c := range hints[0].Cards {
+					req.Cards = append(req.Cards, &pb.Card{Suit: c.Suit, Rank: c.Rank})
+				}
+				data, _ := proto.Marshal(req)
+				messages = append(messages, stubMatchData{op: int64(pb.OpCode_OP_PLAY_CARD), userID: "p1", data: data})
+			} else if canPass {
+				messages = append(messages, stubMatchData{op: int64(pb.OpCode_OP_PASS), userID: "p1"})
+			}
+		}
+		dispatcher.reset()
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, messages)
+		for _, msg := range dispatcher.msgs {
+			if pb.OpCode(msg.op) == pb.OpCode_OP_GAME_OVER {
+				gameOver = &pb.GameOverPacket{}
+				if err := proto.Unmarshal(msg.data, gameOver); err != nil {
+					t.Fatalf("failed to unmarshal GameOverPacket: %v", err)
+				}
+			}
+		}
+	}
+	if gameOver == nil {
+		t.Fatalf("expected the game to finish")
+	}
+	for _, r := range gameOver.Results {
+		if r.Abandoned != (r.PlayerId == "p2") {
+			t.Fatalf("expected only p2 to be marked abandoned, got %+v", r)
+		}
+	}
+
+	// p2 watched the bot finish, so they get the seat back for the next game.
+	seat = s.Seats[s.SeatByUser["p2"]]
+	if seat.IsBot() || seat.UserID != "p2" || s.Spectators["p2"] || len(s.Bots) != 0 {
+		t.Fatalf("expected p2 back in their seat, got %+v", s.Seats)
+	}
+}
+
+func TestPlayerSeatedMidGameIsDealtIntoNextGame(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	params := map[string]interface{}{"turn_seconds": float64(0), "seats": float64(3)}
+	m, s, dispatcher := newTestTable(t, params, "p1", "p2")
+	startGame(t, m, s, dispatcher)
+
+	// p3 takes the free seat but watches until the game is over.
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 1, s, []runtime.Presence{stubPresence{id: "p3"}})
+	if _, seated := s.SeatByUser["p3"]; !seated || !s.Spectators["p3"] {
+		t.Fatalf("expected p3 to hold a seat as a spectator, got %+v", s.Seats)
+	}
+
+	// p1 goes out with the opening card, which ends the game.
+	opening, _ := s.Game.OpeningCard()
+	s.Game.Hands["p1"] = []tienlen.Card{opening}
+	s.Game.TurnOrder = []string{"p1", "p2"}
+	s.Game.CurrentIdx = 0
+	play, _ := proto.Marshal(&pb.PlayCardRequest{CardIndices: []int32{0}})
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, 2, s, []runtime.MatchData{stubMatchData{op: int64(pb.OpCode_OP_PLAY_CARD), userID: "p1", data: play}})
+	if s.Phase != PhaseSettlement {
+		t.Fatalf("expected the settlement, got %s", s.Phase)
+	}
+	if s.Spectators["p3"] || !reflect.DeepEqual(m.orderedSeatedPlayers(s), []string{"p1", "p2", "p3"}) {
+		t.Fatalf("expected p3 to be dealt into the next game, got %v", m.orderedSeatedPlayers(s))
+	}
+}
+
+func TestFreeSeatTakenMidGameWithoutSpectatorSlots(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	params := map[string]interface{}{"turn_seconds": float64(0), "seats": float64(3), "max_spectators": float64(0)}
+	m, s, dispatcher := newTestTable(t, params, "p1", "p2")
+	startGame(t, m, s, dispatcher)
+
+	// p3 takes the free seat and waits for the next deal without a spectator slot.
+	if _, ok, reason := m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 1, s, stubPresence{id: "p3"}, nil); !ok {
+		t.Fatalf("expected p3 to be let in to the free seat, got %q", reason)
+	}
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 1, s, []runtime.Presence{stubPresence{id: "p3"}})
+	if _, seated := s.SeatByUser["p3"]; !seated {
+		t.Fatalf("expected p3 to hold a seat, got %+v", s.Seats)
+	}
+	var label Label
+	if err := json.Unmarshal([]byte(s.Label), &label); err != nil || label.Spectators != 0 {
+		t.Fatalf("expected no spectators in the label, got %s", s.Label)
+	}
+
+	// The table is now full and has no room for watchers.
+	if _, ok, reason := m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 1, s, stubPresence{id: "p4"}, nil); ok || reason != "Match is full" {
+		t.Fatalf("expected p4 to be turned away, got %v, %q", ok, reason)
+	}
+}
+
+func TestFailedDealWaitsForPlayersToReadyAgain(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	params := map[string]interface{}{"auto_restart": true}
+	m, s, dispatcher := newTestTable(t, params, "p1", "p2")
+	s.LastGameLog = []tienlen.LogEntry{{Seq: 1, Kind: tienlen.CommandStart}}
+
+	// No seed can be drawn, so the deal fails.
+	defer func(seed func() (string, error)) { newServerSeed = seed }(newServerSeed)
+	newServerSeed = func() (string, error) { return "", errors.New("no entropy") }
+	s.NextServerSeed = ""
+
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, 1, s, nil)
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, 2, s, nil)
+	if s.Phase != PhaseDealing {
+		t.Fatalf("expected the auto-restart room to count down to the deal, got %s", s.Phase)
+	}
+	for tick := int64(3); tick <= 2+dealCountdownSeconds*tickRate; tick++ {
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, nil)
+	}
+	if s.Game.IsPlaying() || s.Phase != PhaseReadyCheck || len(s.Ready) != 0 {
+		t.Fatalf("expected a ready check after the failed deal, got phase %s, ready %v", s.Phase, s.Ready)
+	}
+
+	// The table does not go straight back to dealing.
+	for tick := int64(3 + dealCountdownSeconds*tickRate); tick < 100+dealCountdownSeconds*tickRate; tick++ {
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, nil)
+	}
+	if s.Phase != PhaseReadyCheck {
+		t.Fatalf("expected to wait for the players, got %s", s.Phase)
+	}
+}
+
+func TestReadyCheckDealsAndRestarts(t *testing.T) {
+	m := &Match{}
+	logger := testLogger{t}
+	dispatcher := &recordingDispatcher{}
+	ctx := context.Background()
+
+	state, _, _ := m.MatchInit(ctx, logger, nil, nil, map[string]interface{}{"turn_seconds": float64(0)})
+	s := state.(*MatchState)
+	loop := func(tick int64, messages ...runtime.MatchData) {
+		m.MatchLoop(ctx, logger, nil, nil, dispatcher, tick, s, messages)
+	}
+	ready := func(userID string) runtime.MatchData {
+		return stubMatchData{op: int64(pb.OpCode_OP_READY), userID: userID}
+	}
+
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 0, s, []runtime.Presence{stubPresence{id: "p1"}})
+	loop(1)
+	if s.Phase != PhaseWaiting {
+		t.Fatalf("expected to wait for a second player, got %s", s.Phase)
+	}
+
+	// Alone at the table, not even the owner can deal.
+	dispatcher.reset()
+	loop(1, stubMatchData{op: int64(pb.OpCode_OP_GAME_START_REQUEST), userID: "p1"})
+	if last := dispatcher.msgs[len(dispatcher.msgs)-1]; s.Game.IsPlaying() || pb.OpCode(last.op) != pb.OpCode_OP_ERROR {
+		t.Fatalf("expected a start request with one player to be rejected")
+	}
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 2, s, []runtime.Presence{stubPresence{id: "p2"}})
+	loop(2)
+	if s.Phase != PhaseReadyCheck {
+		t.Fatalf("expected a ready check, got %s", s.Phase)
+	}
+
+	// Only the owner may skip the ready check.
+	dispatcher.reset()
+	loop(3, stubMatchData{op: int64(pb.OpCode_OP_GAME_START_REQUEST), userID: "p2"})
+	if s.Game.IsPlaying() || pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_ERROR {
+		t.Fatalf("expected a start request from p2 to be rejected")
+	}
+
+	loop(4, ready("p1"))
+	if s.Phase != PhaseReadyCheck {
+		t.Fatalf("expected to wait for p2, got %s", s.Phase)
+	}
+	dispatcher.reset()
+	loop(5, ready("p2"))
+	packet := &pb.MatchStatePacket{}
+	if err := proto.Unmarshal(dispatcher.msgs[len(dispatcher.msgs)-1].data, packet); err != nil {
+		t.Fatalf("failed to unmarshal MatchStatePacket: %v", err)
+	}
+	if s.Phase != PhaseDealing || packet.Phase != string(PhaseDealing) || packet.PhaseDeadlineUnixMs <= 0 || len(packet.ReadyIds) != 2 {
+		t.Fatalf("expected the deal countdown to be broadcast, got %+v", packet)
+	}
+
+	// The cards are dealt when the countdown ends.
+	for tick := int64(6); tick < 5+dealCountdownSeconds*tickRate; tick++ {
+		loop(tick)
+	}
+	if s.Game.IsPlaying() {
+		t.Fatalf("expected no deal before the countdown ends")
+	}
+	loop(5 + dealCountdownSeconds*tickRate)
+	if !s.Game.IsPlaying() || s.Phase != PhasePlaying || len(s.Ready) != 0 {
+		t.Fatalf("expected the game to start, got phase %s, ready %v", s.Phase, s.Ready)
+	}
+	dispatcher.reset()
+	loop(40, ready("p1"))
+	if pb.OpCode(dispatcher.msgs[0].op) != pb.OpCode_OP_ERROR || string(dispatcher.msgs[0].data) != "Game already started" {
+		t.Fatalf("expected OP_READY to be rejected during a game, got %q", dispatcher.msgs[0].data)
+	}
+
+	// p1 goes out with the opening card, which ends the game.
+	opening, _ := s.Game.OpeningCard()
+	s.Game.Hands["p1"] = []tienlen.Card{opening}
+	s.Game.TurnOrder = []string{"p1", "p2"}
+	s.Game.CurrentIdx = 0
+	play, _ := proto.Marshal(&pb.PlayCardRequest{CardIndices: []int32{0}})
+	loop(41, stubMatchData{op: int64(pb.OpCode_OP_PLAY_CARD), userID: "p1", data: play})
+	if s.Game.IsPlaying() || s.Phase != PhaseSettlement {
+		t.Fatalf("expected the settlement, got phase %s", s.Phase)
+	}
+
+	// Ready players carry over from the settlement; the next game starts on its own.
+	loop(42, ready("p1"))
+	loop(41 + settlementSeconds*tickRate - 1)
+	if s.Phase != PhaseSettlement {
+		t.Fatalf("expected the results to stay up, got %s", s.Phase)
+	}
+	loop(41 + settlementSeconds*tickRate)
+	if s.Phase != PhaseIntermission {
+		t.Fatalf("expected an intermission, got %s", s.Phase)
+	}
+	loop(100, ready("p2"))
+	if s.Phase != PhaseDealing {
+		t.Fatalf("expected the deal countdown, got %s", s.Phase)
+	}
+	loop(100 + dealCountdownSeconds*tickRate)
+	if !s.Game.IsPlaying() {
+		t.Fatalf("expected the next game to start")
+	}
+}
+
+func TestOwnerStartSkipsReadyCheck(t *testing.T) {
+	m, s, dispatcher := newTestTable(t, nil, "p1", "p2")
+	m.MatchLoop(context.Background(), testLogger{t}, nil, nil, dispatcher, 1, s, nil)
+	if s.Phase != PhaseReadyCheck || len(s.Ready) != 0 {
+		t.Fatalf("expected a ready check nobody answered, got phase %s, ready %v", s.Phase, s.Ready)
+	}
+
+	// Clients that never send OP_READY still get a game through the owner's start request.
+	startGame(t, m, s, dispatcher)
+	if !s.Game.IsPlaying() || s.Phase != PhasePlaying {
+		t.Fatalf("expected the owner's start request to deal, got phase %s", s.Phase)
+	}
+}
+
+func TestTurnOrderFollowsSeats(t *testing.T) {
+	tests := []struct {
+		direction string
+		want      []string
+	}{
+		{"", []string{"p1", "p4", "p3", "p2"}},
+		{"clockwise", []string{"p1", "p2", "p3", "p4"}},
+	}
+	for _, tt := range tests {
+		m, s, dispatcher := newTestTable(t, map[string]interface{}{"direction": tt.direction}, "p1", "p2", "p3", "p4")
+		startGame(t, m, s, dispatcher)
+
+		if !reflect.DeepEqual(s.Game.TurnOrder, tt.want) {
+			t.Fatalf("direction %q: expected turn order %v, got %v", tt.direction, tt.want, s.Game.TurnOrder)
+		}
+		start := lastPacket(t, dispatcher, pb.OpCode_OP_GAME_START, &pb.MatchStartPacket{})
+		if seats := []string{"p1", "p2", "p3", "p4"}; !reflect.DeepEqual(start.PlayerIds, seats) || !reflect.DeepEqual(start.TurnOrder, tt.want) {
+			t.Fatalf("direction %q: expected seats %v and turn order %v in the start packet, got %v and %v", tt.direction, seats, tt.want, start.PlayerIds, start.TurnOrder)
+		}
+		packet := lastPacket(t, dispatcher, pb.OpCode_OP_MATCH_STATE, &pb.MatchStatePacket{})
+		if want := string(s.Config.Direction); packet.Direction != want {
+			t.Fatalf("direction %q: expected %q in the match state, got %q", tt.direction, want, packet.Direction)
+		}
+	}
+}
+
+func TestParseRoomConfig(t *testing.T) {
+	c, err := ParseRoomConfig("")
+	if err != nil || !reflect.DeepEqual(c, DefaultRoomConfig()) {
+		t.Fatalf("expected the defaults for an empty payload, got %+v, %v", c, err)
+	}
+	c, err = ParseRoomConfig(`{"variant":"northern","seats":6,"stake":50,"private":true,"hints_allowed":false,"direction":"clockwise"}`)
+	if err != nil {
+		t.Fatalf("expected a valid config, got %v", err)
+	}
+	if c.Variant != tienlen.VariantNorthern || c.Seats != 6 || c.Stake != 50 || !c.Private || c.HintsAllowed ||
+		c.Direction != tienlen.Clockwise || c.TurnSeconds != defaultTurnSeconds || c.MaxSpectators != defaultMaxSpectators {
+		t.Fatalf("expected the payload over the defaults, got %+v", c)
+	}
+
+	for _, payload := range []string{
+		`not json`,
+		`{"seet":4}`,
+		`{"variant":"western"}`,
+		`{"seats":1}`,
+		`{"seats":9}`,
+		`{"hand_size":20}`,
+		`{"hand_size":20,"seats":6}`,
+		`{"turn_seconds":-1}`,
+		`{"increment_seconds":5}`,
+		`{"direction":"sideways"}`,
+		`{"stake":-10}`,
+		`{"max_spectators":1000}`,
+		`{"password":"` + strings.Repeat("x", maxPasswordLength+1) + `"}`,
+	} {
+		if _, err := ParseRoomConfig(payload); err == nil {
+			t.Errorf("expected %s to be rejected", payload)
+		}
+	}
+
+	// The config survives the round trip through MatchInit params.
+	c, _ = ParseRoomConfig(`{"seats":3,"auto_restart":true,"max_spectators":2}`)
+	if got, err := roomConfigFromParams(c.Params()); err != nil || !reflect.DeepEqual(got, c) {
+		t.Fatalf("expected %+v back from the params, got %+v, %v", c, got, err)
+	}
+}
+
+func TestRoomConfigLimitsSpectatorsAndRestarts(t *testing.T) {
+	logger := testLogger{t}
+	ctx := context.Background()
+
+	params := map[string]interface{}{"seats": float64(2), "max_spectators": float64(1), "auto_restart": true, "stake": float64(20)}
+	m, s, dispatcher := newTestTable(t, params, "p1", "p2")
+
+	packet := lastPacket(t, dispatcher, pb.OpCode_OP_MATCH_STATE, &pb.MatchStatePacket{})
+	if cfg := packet.GetConfig(); cfg.GetSeats() != 2 || cfg.GetStake() != 20 || !cfg.GetAutoRestart() || cfg.GetMaxSpectators() != 1 {
+		t.Fatalf("expected the room config in the match state, got %+v", cfg)
+	}
+
+	// The table is full: one newcomer may watch, the next is turned away.
+	if _, ok, reason := m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 0, s, stubPresence{id: "p3"}, nil); !ok {
+		t.Fatalf("expected p3 to be let in to watch, got %q", reason)
+	}
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 0, s, []runtime.Presence{stubPresence{id: "p3"}})
+	if !s.Spectators["p3"] {
+		t.Fatalf("expected p3 to be a spectator")
+	}
+	if _, ok, reason := m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 0, s, stubPresence{id: "p4"}, nil); ok || reason != "Match is full" {
+		t.Fatalf("expected p4 to be turned away, got %v, %q", ok, reason)
+	}
+
+	// The first game waits for the ready check; after it, the next game deals by itself.
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, 1, s, nil)
+	if s.Phase != PhaseReadyCheck {
+		t.Fatalf("expected a ready check before the first game, got %s", s.Phase)
+	}
+	s.LastGameLog = []tienlen.LogEntry{{Seq: 1, Kind: tienlen.CommandStart}}
+	s.Phase = PhaseIntermission
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, 2, s, nil)
+	if s.Phase != PhaseDealing {
+		t.Fatalf("expected the next game to deal without a ready check, got %s", s.Phase)
+	}
+}
+
+func TestPasswordProtectedRoom(t *testing.T) {
+	m := &Match{}
+	logger := testLogger{t}
+	dispatcher := &recordingDispatcher{}
+	ctx := context.Background()
+
+	params := map[string]interface{}{"private": true, "password": "hunter2", "join_code": "K7QM2X"}
+	state, _, _ := m.MatchInit(ctx, logger, nil, nil, params)
+	s := state.(*MatchState)
+
+	attempt := func(userID string, metadata map[string]string) (bool, string) {
+		_, ok, reason := m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 0, s, stubPresence{id: userID}, metadata)
+		return ok, reason
+	}
+	if ok, reason := attempt("p1", map[string]string{"join_code": "K7QM2X"}); ok || reason != "This room needs a password" {
+		t.Fatalf("expected a join without a password to be refused, got %v, %q", ok, reason)
+	}
+	if ok, reason := attempt("p1", map[string]string{"join_code": "K7QM2X", "password": "hunter3"}); ok || reason != "Wrong password" {
+		t.Fatalf("expected a wrong password to be refused, got %v, %q", ok, reason)
+	}
+	if ok, reason := attempt("p1", map[string]string{"join_code": "K7QM2X", "password": "hunter2"}); !ok {
+		t.Fatalf("expected the right password to be accepted, got %q", reason)
+	}
+
+	// Seated players coming back are not asked again.
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 0, s, []runtime.Presence{stubPresence{id: "p1"}})
+	if ok, reason := attempt("p1", nil); !ok {
+		t.Fatalf("expected p1 to rejoin their seat, got %q", reason)
+	}
+
+	// Clients learn that the room is locked and its code, never the password.
+	for _, msg := range dispatcher.msgs {
+		if bytes.Contains(msg.data, []byte("hunter2")) {
+			t.Fatalf("expected the password to stay on the server")
+		}
+	}
+	packet := lastPacket(t, dispatcher, pb.OpCode_OP_MATCH_STATE, &pb.MatchStatePacket{})
+	if cfg := packet.GetConfig(); !cfg.GetHasPassword() || cfg.GetJoinCode() != "K7QM2X" {
+		t.Fatalf("expected a locked room with its join code, got %+v", cfg)
+	}
+}
+
+func TestTierStakeFallsInItsTier(t *testing.T) {
+	for _, tier := range []string{StakeFree, StakeLow, StakeMid, StakeHigh} {
+		if got := StakeTier(TierStake(tier)); got != tier {
+			t.Errorf("expected the stake for %s to be in that tier, got %s", tier, got)
+		}
+	}
+}
+
+func TestMatchLabelTracksTable(t *testing.T) {
+	m := &Match{}
+	logger := testLogger{t}
+	dispatcher := &recordingDispatcher{}
+	ctx := context.Background()
+
+	params := map[string]interface{}{"variant": "northern", "stake": float64(250), "seats": float64(3), "turn_seconds": float64(0)}
+	state, _, initial := m.MatchInit(ctx, logger, nil, nil, params)
+	s := state.(*MatchState)
+	decode := func(raw string) Label {
+		var label Label
+		if err := json.Unmarshal([]byte(raw), &label); err != nil {
+			t.Fatalf("failed to decode label %q: %v", raw, err)
+		}
+		return label
+	}
+	want := Label{OpenSeats: 3, Phase: PhaseWaiting, Variant: tienlen.VariantNorthern, StakeTier: StakeMid}
+	if got := decode(initial); got != want {
+		t.Fatalf("expected the initial label %+v, got %+v", want, got)
+	}
+
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 0, s, []runtime.Presence{stubPresence{id: "p1"}, stubPresence{id: "p2"}})
+	want.OpenSeats = 1
+	if got := decode(dispatcher.labels[len(dispatcher.labels)-1]); got != want {
+		t.Fatalf("expected %+v after the joins, got %+v", want, got)
+	}
+
+	// Once the game is dealt, quick match no longer sees the table as open to newcomers.
+	// p3 waits in the last seat for the next deal and does not count as a spectator.
+	startGame(t, m, s, dispatcher)
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, 1, s, nil)
+	m.MatchJoin(ctx, logger, nil, nil, dispatcher, 2, s, []runtime.Presence{stubPresence{id: "p3"}})
+	want.OpenSeats, want.Phase = 0, PhasePlaying
+	if got := decode(dispatcher.labels[len(dispatcher.labels)-1]); got != want {
+		t.Fatalf("expected %+v during the game, got %+v", want, got)
+	}
+
+	// Unchanged labels are not published again.
+	published := len(dispatcher.labels)
+	m.MatchLoop(ctx, logger, nil, nil, dispatcher, 3, s, nil)
+	if len(dispatcher.labels) != published {
+		t.Fatalf("expected no label update without a change")
+	}
+}
+
+func TestPrivateRoomNeedsJoinCode(t *testing.T) {
+	m := &Match{}
+	logger := testLogger{t}
+	dispatcher := &recordingDispatcher{}
+	ctx := context.Background()
+
+	state, _, _ := m.MatchInit(ctx, logger, nil, nil, map[string]interface{}{"private": true, "join_code": "K7QM2X"})
+	s := state.(*MatchState)
+
+	attempt := func(metadata map[string]string) (bool, string) {
+		_, ok, reason := m.MatchJoinAttempt(ctx, logger, nil, nil, dispatcher, 0, s, stubPresence{id: "p1"}, metadata)
+		return ok, reason
+	}
+	if ok, reason := attempt(nil); ok || reason != "This room is private" {
+		t.Fatalf("expected a join without the code to be refused, got %v, %q", ok, reason)
+	}
+	if ok, reason := attempt(map[string]string{"join_code": "ABCDEF"}); ok || reason != "This room is private" {
+		t.Fatalf("expected a wrong code to be refused, got %v, %q", ok, reason)
+	}
+	if ok, reason := attempt(map[string]string{"join_code": " k7qm2x"}); !ok {
+		t.Fatalf("expected the code to be accepted as typed, got %q", reason)
+	}
+}
+
+func TestJoinCodeReleasedWhenRoomEmpties(t *testing.T) {
+	ctx := context.Background()
+	logger := testLogger{t}
+	nk := &storageRecorder{}
+
+	m, s, dispatcher := newTestTable(t, map[string]interface{}{"private": true, "join_code": "K7QM2X"}, "p1", "p2")
+	if state := m.MatchLeave(ctx, logger, nil, nk, dispatcher, 0, s, []runtime.Presence{stubPresence{id: "p2"}}); state == nil {
+		t.Fatalf("expected the match to go on while p1 is still here")
+	}
+	if len(nk.deletes) != 0 {
+		t.Fatalf("expected the code to stay taken while players remain, got %d deletes", len(nk.deletes))
+	}
+	if state := m.MatchLeave(ctx, logger, nil, nk, dispatcher, 0, s, []runtime.Presence{stubPresence{id: "p1"}}); state != nil {
+		t.Fatalf("expected the match to end once everyone left")
+	}
+	if len(nk.deletes) != 1 || nk.deletes[0].Collection != JoinCodeCollection || nk.deletes[0].Key != "K7QM2X" {
+		t.Fatalf("expected the join code to be released, got %+v", nk.deletes)
+	}
+}
@@ -0,0 +1,175 @@
+package match
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/yourusername/tienlen-server/internal/match/adapter"
+)
+
+// Phase is a step in the lifecycle of a match. MatchLoop moves the match through
+//
+//	Waiting -> ReadyCheck -> Dealing -> Playing -> Settlement -> Intermission -> Dealing -> ...
+//
+// falling back to Waiting whenever fewer than minPlayers are seated between games.
+type Phase string
+
+const (
+	PhaseWaiting      Phase = "waiting"      // Fewer than minPlayers seated
+	PhaseReadyCheck   Phase = "ready_check"  // Waiting for every seated player to send OP_READY
+	PhaseDealing      Phase = "dealing"      // Everyone is ready; the cards are dealt when the countdown ends
+	PhasePlaying      Phase = "playing"      // A game is in progress
+	PhaseSettlement   Phase = "settlement"   // The game is over and its results are shown
+	PhaseIntermission Phase = "intermission" // Between games, waiting for everyone to be ready again
+)
+
+// minPlayers is the smallest table that can be dealt.
+const minPlayers = 2
+
+// The deal follows dealCountdownSeconds after everyone is ready, and the results of a game
+// stay on screen for settlementSeconds before the next ready check.
+const (
+	dealCountdownSeconds = 3
+	settlementSeconds    = 5
+)
+
+// enterPhase moves the match to phase, which ends after seconds if it is timed, and tells
+// everyone.
+func (m *Match) enterPhase(s *MatchState, dispatcher runtime.MatchDispatcher, phase Phase, seconds int) {
+	s.Phase = phase
+	s.PhaseEndsAtTick, s.PhaseDeadline = 0, 0
+	if phase == PhaseSettlement || phase == PhaseIntermission {
+		s.seatSpectators()
+	}
+	if seconds > 0 {
+		s.PhaseEndsAtTick = s.Tick + int64(seconds*tickRate)
+		s.PhaseDeadline = now().Add(time.Duration(seconds) * time.Second).UnixMilli()
+	}
+	adapter.BroadcastPhaseChanged(dispatcher, s.table(), s.Game)
+}
+
+// seatSpectators stops treating players who took a seat during a game as spectators, so
+// they are dealt into the next one. Seats a bot still plays for are left alone.
+func (s *MatchState) seatSpectators() {
+	for uid := range s.Spectators {
+		if slot, ok := s.SeatByUser[uid]; ok && !s.Seats[slot].IsBot() {
+			delete(s.Spectators, uid)
+		}
+	}
+}
+
+// watchers counts the spectators who hold no seat. Players waiting in a seat for the
+// next deal are not counted against the room's spectator limit.
+func (s *MatchState) watchers() int {
+	count := 0
+	for uid := range s.Spectators {
+		if _, seated := s.SeatByUser[uid]; !seated {
+			count++
+		}
+	}
+	return count
+}
+
+// runPhase advances the match between games: it opens the ready check once enough players
+// are seated, counts down to the deal once they are all ready, and moves on from the
+// results of a finished game. Games start and end through startNewGame and dispatchGameEvents.
+func (m *Match) runPhase(s *MatchState, dispatcher runtime.MatchDispatcher, logger runtime.Logger, tick int64) {
+	if s.Phase == PhasePlaying {
+		return
+	}
+	if s.Phase == PhaseSettlement {
+		if tick < s.PhaseEndsAtTick {
+			return
+		}
+		m.enterPhase(s, dispatcher, PhaseIntermission, 0)
+	}
+
+	enough := len(m.orderedSeatedPlayers(s)) >= minPlayers
+	switch {
+	case !enough:
+		if s.Phase != PhaseWaiting {
+			m.enterPhase(s, dispatcher, PhaseWaiting, 0)
+		}
+	case s.Phase == PhaseWaiting:
+		m.enterPhase(s, dispatcher, PhaseReadyCheck, 0)
+	case s.Phase == PhaseDealing && !m.allReady(s):
+		m.enterPhase(s, dispatcher, PhaseReadyCheck, 0)
+	case s.Phase == PhaseDealing && tick >= s.PhaseEndsAtTick:
+		if err := m.startNewGame(s, dispatcher, logger); err != nil {
+			// Everyone readies again rather than the table retrying the same failing deal.
+			logger.Error("Could not deal: %v", err)
+			s.Ready = make(map[string]bool)
+			s.DealFailed = true
+			m.enterPhase(s, dispatcher, PhaseReadyCheck, 0)
+		}
+	case s.Phase != PhaseDealing && m.allReady(s):
+		m.enterPhase(s, dispatcher, PhaseDealing, dealCountdownSeconds)
+	}
+}
+
+// startNow lets the owner deal at once instead of waiting for the ready check, as long as at
+// least minPlayers are seated and the results of the last game are no longer up. It stays the
+// way to start a game until the client sends OP_READY.
+func (m *Match) startNow(s *MatchState, dispatcher runtime.MatchDispatcher, logger runtime.Logger) error {
+	if err := m.ready(s, dispatcher, s.OwnerID, ""); err != nil {
+		return err
+	}
+	switch {
+	case s.Phase == PhaseSettlement:
+		return errors.New("the results of the last game are still up")
+	case len(m.orderedSeatedPlayers(s)) < minPlayers:
+		return errors.New("waiting for more players")
+	}
+	return m.startNewGame(s, dispatcher, logger)
+}
+
+// ready marks a seated player as ready for the next game and mixes the entropy they send,
+// if any, into its deal.
+func (m *Match) ready(s *MatchState, dispatcher runtime.MatchDispatcher, userID, entropy string) error {
+	if s.Game.IsPlaying() {
+		return errors.New("game already started")
+	}
+	if _, ok := s.SeatByUser[userID]; !ok || s.Spectators[userID] {
+		return errors.New("take a seat first")
+	}
+	s.addEntropy(userID, entropy)
+	if s.Ready[userID] {
+		return nil
+	}
+	s.Ready[userID] = true
+	adapter.BroadcastPhaseChanged(dispatcher, s.table(), s.Game)
+	return nil
+}
+
+// allReady reports whether every seated human has confirmed OP_READY. Bots are always ready,
+// but a table of bots alone never starts. In auto-restart rooms everyone counts as ready once
+// the first game has been played, unless the last deal failed.
+func (m *Match) allReady(s *MatchState) bool {
+	autoReady := s.Config.AutoRestart && s.LastGameLog != nil && !s.DealFailed
+	humans := 0
+	for _, uid := range m.orderedSeatedPlayers(s) {
+		if _, isBot := s.Bots[uid]; isBot {
+			continue
+		}
+		if !s.Ready[uid] && !autoReady {
+			return false
+		}
+		humans++
+	}
+	return humans > 0
+}
+
+// readyIDs lists the players ready for the next game, in sorted order.
+func (s *MatchState) readyIDs() []string {
+	if len(s.Ready) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(s.Ready))
+	for uid := range s.Ready {
+		out = append(out, uid)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,276 @@
+// Package sim plays headless bot-only games on the pure tienlen engine and aggregates
+// statistics, for balancing rule variants and tuning bot strategies without Nakama.
+package sim
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/yourusername/tienlen-server/internal/bot"
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+)
+
+// maxMovesPerGame aborts a game that fails to finish, which would indicate an engine or bot bug.
+const maxMovesPerGame = 10000
+
+// Config describes a simulation run.
+type Config struct {
+	Games   int
+	Workers int
+	Rules   tienlen.RuleSet
+	// Strategies assigns a bot strategy to each seat; its length is the number of players.
+	Strategies []string
+	// Seed makes the run reproducible: game i is dealt from Seed+i and its bots draw from
+	// sources derived from that seed, whichever worker plays it. Zero picks a random seed.
+	Seed int64
+}
+
+// Stats aggregates the outcome of every simulated game.
+type Stats struct {
+	Games      int
+	Strategies []string // Strategy per seat
+
+	SeatWins   []int // 1st places per seat
+	SeatPoints []int // Settlement totals per seat
+
+	Moves              int // Plays and passes over all games
+	Chops              int // Chops, counting every over-chop of a chain
+	InstantWins        int
+	InstantWinPatterns map[tienlen.InstantWinPattern]int
+
+	// Leftovers maps the number of cards a player still held at game end to how often it happened.
+	Leftovers map[int]int
+}
+
+func newStats(strategies []string) *Stats {
+	return &Stats{
+		Strategies:         strategies,
+		SeatWins:           make([]int, len(strategies)),
+		SeatPoints:         make([]int, len(strategies)),
+		InstantWinPatterns: make(map[tienlen.InstantWinPattern]int),
+		Leftovers:          make(map[int]int),
+	}
+}
+
+// Run plays cfg.Games games across cfg.Workers goroutines and returns the combined statistics.
+func Run(cfg Config) (*Stats, error) {
+	if cfg.Games <= 0 {
+		return nil, errors.New("games must be positive")
+	}
+	if len(cfg.Strategies) < 2 || len(cfg.Strategies) > tienlen.MaxPlayers {
+		return nil, fmt.Errorf("need 2 to %d seats, got %d", tienlen.MaxPlayers, len(cfg.Strategies))
+	}
+	for _, strategy := range cfg.Strategies {
+		if _, err := bot.New(strategy, nil); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Rules == nil {
+		cfg.Rules = tienlen.SouthernRules{}
+	}
+	if cfg.Seed == 0 {
+		cfg.Seed = tienlen.NewSeed()
+	}
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > cfg.Games {
+		workers = cfg.Games
+	}
+
+	jobs := make(chan int)
+	results := make(chan *Stats, workers)
+	errs := make(chan error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stats, err := work(cfg, jobs)
+			if err != nil {
+				errs <- err
+				return
+			}
+			results <- stats
+		}()
+	}
+
+	go func() {
+		for i := 0; i < cfg.Games; i++ {
+			jobs <- i
+		}
+		close(jobs)
+	}()
+	wg.Wait()
+	close(results)
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	total := newStats(cfg.Strategies)
+	for stats := range results {
+		total.merge(stats)
+	}
+	return total, nil
+}
+
+// work plays games until jobs is drained. On error it keeps draining jobs so the
+// producer never blocks.
+func work(cfg Config, jobs <-chan int) (*Stats, error) {
+	players := make([]string, len(cfg.Strategies))
+	for i := range cfg.Strategies {
+		players[i] = fmt.Sprintf("seat%d", i+1)
+	}
+
+	stats := newStats(cfg.Strategies)
+	var firstErr error
+	for i := range jobs {
+		if firstErr != nil {
+			continue
+		}
+		if err := playGame(cfg, cfg.Seed+int64(i), players, stats); err != nil {
+			firstErr = fmt.Errorf("game with seed %d: %w", cfg.Seed+int64(i), err)
+		}
+	}
+	return stats, firstErr
+}
+
+// playGame plays the game dealt from seed to the end and records it in stats.
+func playGame(cfg Config, seed int64, players []string, stats *Stats) error {
+	bots := make(map[string]bot.Bot, len(players))
+	for i, strategy := range cfg.Strategies {
+		bots[players[i]], _ = bot.New(strategy, rand.New(rand.NewSource(seed+int64(i)+1)))
+	}
+
+	g := tienlen.NewGameWithRules(cfg.Rules)
+	events, err := g.StartWithSeed(seed, players, players[0], "")
+	if err != nil {
+		return err
+	}
+
+	moves := 0
+	for g.IsPlaying() {
+		if moves >= maxMovesPerGame {
+			return fmt.Errorf("game did not finish after %d moves", moves)
+		}
+		active := g.Snapshot().ActivePlayerID
+		moveEvents, err := bot.Act(g, bots[active], active)
+		if err != nil {
+			return fmt.Errorf("bot %s: %w", active, err)
+		}
+		events = append(events, moveEvents...)
+		moves++
+	}
+
+	seat := make(map[string]int, len(players))
+	for i, uid := range players {
+		seat[uid] = i
+	}
+	stats.Games++
+	stats.Moves += moves
+	for _, ev := range events {
+		switch e := ev.(type) {
+		case tienlen.ChopOccurred:
+			stats.Chops++
+		case tienlen.InstantWin:
+			stats.InstantWins++
+			stats.InstantWinPatterns[e.Pattern]++
+		case tienlen.GameOver:
+			stats.SeatWins[seat[e.WinnerID]]++
+			for _, r := range e.Settlement.Results {
+				stats.SeatPoints[seat[r.PlayerID]] += r.Total
+				if r.Place > 1 && len(r.RemainingHand) > 0 {
+					stats.Leftovers[len(r.RemainingHand)]++
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Stats) merge(other *Stats) {
+	s.Games += other.Games
+	s.Moves += other.Moves
+	s.Chops += other.Chops
+	s.InstantWins += other.InstantWins
+	for i := range s.SeatWins {
+		s.SeatWins[i] += other.SeatWins[i]
+		s.SeatPoints[i] += other.SeatPoints[i]
+	}
+	for p, n := range other.InstantWinPatterns {
+		s.InstantWinPatterns[p] += n
+	}
+	for cards, n := range other.Leftovers {
+		s.Leftovers[cards] += n
+	}
+}
+
+// StrategyWinRates returns, per strategy, the fraction of its seat-games that it won.
+func (s *Stats) StrategyWinRates() map[string]float64 {
+	wins := make(map[string]int)
+	seats := make(map[string]int)
+	for i, strategy := range s.Strategies {
+		wins[strategy] += s.SeatWins[i]
+		seats[strategy]++
+	}
+	rates := make(map[string]float64, len(seats))
+	for strategy, n := range seats {
+		rates[strategy] = ratio(wins[strategy], n*s.Games)
+	}
+	return rates
+}
+
+// Report writes a human-readable summary of the statistics.
+func (s *Stats) Report(w io.Writer) {
+	fmt.Fprintf(w, "games:          %d\n", s.Games)
+	fmt.Fprintf(w, "avg moves/game: %.1f\n", ratio(s.Moves, s.Games))
+	fmt.Fprintf(w, "chops/game:     %.3f\n", ratio(s.Chops, s.Games))
+	fmt.Fprintf(w, "instant wins:   %d (%.2f%%)\n", s.InstantWins, 100*ratio(s.InstantWins, s.Games))
+	for _, p := range sortedKeys(s.InstantWinPatterns) {
+		fmt.Fprintf(w, "  %-24s %d\n", p, s.InstantWinPatterns[p])
+	}
+
+	fmt.Fprintln(w, "\nseat  strategy  win rate  avg points")
+	for i, strategy := range s.Strategies {
+		fmt.Fprintf(w, "%-4d  %-8s  %7.2f%%  %10.3f\n", i+1, strategy,
+			100*ratio(s.SeatWins[i], s.Games), ratio(s.SeatPoints[i], s.Games))
+	}
+
+	fmt.Fprintln(w, "\nstrategy  win rate")
+	rates := s.StrategyWinRates()
+	for _, strategy := range sortedKeys(rates) {
+		fmt.Fprintf(w, "%-8s  %7.2f%%\n", strategy, 100*rates[strategy])
+	}
+
+	fmt.Fprintln(w, "\ncards left  players")
+	counts := make([]int, 0, len(s.Leftovers))
+	for cards := range s.Leftovers {
+		counts = append(counts, cards)
+	}
+	sort.Ints(counts)
+	for _, cards := range counts {
+		fmt.Fprintf(w, "%10d  %d\n", cards, s.Leftovers[cards])
+	}
+}
+
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
+
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
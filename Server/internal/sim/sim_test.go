@@ -0,0 +1,101 @@
+package sim
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/tienlen-server/internal/tienlen"
+)
+
+func TestRunAggregatesStats(t *testing.T) {
+	for _, rules := range []tienlen.RuleSet{tienlen.SouthernRules{}, tienlen.NorthernRules{}} {
+		t.Run(string(rules.Variant()), func(t *testing.T) {
+			stats, err := Run(Config{
+				Games:      200,
+				Workers:    4,
+				Rules:      rules,
+				Strategies: []string{"greedy", "random", "greedy", "random"},
+			})
+			if err != nil {
+				t.Fatalf("Run error: %v", err)
+			}
+			if stats.Games != 200 {
+				t.Fatalf("expected 200 games, got %d", stats.Games)
+			}
+
+			wins, points := 0, 0
+			for i := range stats.SeatWins {
+				wins += stats.SeatWins[i]
+				points += stats.SeatPoints[i]
+			}
+			if wins != stats.Games {
+				t.Fatalf("expected one winner per game, got %d wins", wins)
+			}
+			if points != 0 {
+				t.Fatalf("expected settlements to be zero-sum, got %d", points)
+			}
+			if stats.Moves == 0 || len(stats.Leftovers) == 0 {
+				t.Fatalf("expected moves and leftovers to be recorded: %+v", stats)
+			}
+
+			rates := stats.StrategyWinRates()
+			if sum := 2 * (rates["greedy"] + rates["random"]); sum < 0.999 || sum > 1.001 {
+				t.Fatalf("expected strategy win rates to cover every game, got %v", rates)
+			}
+
+			var out bytes.Buffer
+			stats.Report(&out)
+			if !strings.Contains(out.String(), "games:          200") {
+				t.Fatalf("unexpected report:\n%s", out.String())
+			}
+		})
+	}
+}
+
+func TestRunLargeTables(t *testing.T) {
+	for _, rules := range []tienlen.RuleSet{tienlen.SouthernRules{}, tienlen.NorthernRules{}} {
+		t.Run(string(rules.Variant()), func(t *testing.T) {
+			strategies := []string{"greedy", "random", "greedy", "random", "greedy", "random", "greedy", "random"}
+			stats, err := Run(Config{Games: 50, Workers: 4, Rules: rules, Strategies: strategies, Seed: 7})
+			if err != nil {
+				t.Fatalf("Run error: %v", err)
+			}
+			wins := 0
+			for _, n := range stats.SeatWins {
+				wins += n
+			}
+			if wins != stats.Games {
+				t.Fatalf("expected one winner per game, got %d wins in %d games", wins, stats.Games)
+			}
+		})
+	}
+}
+
+func TestRunIsReproducibleFromSeed(t *testing.T) {
+	run := func(workers int) *Stats {
+		stats, err := Run(Config{Games: 50, Workers: workers, Strategies: []string{"random", "greedy", "random"}, Seed: 99})
+		if err != nil {
+			t.Fatalf("Run error: %v", err)
+		}
+		return stats
+	}
+	if a, b := run(1), run(3); !reflect.DeepEqual(a, b) {
+		t.Fatalf("expected identical stats from the same seed:\n%+v\n%+v", a, b)
+	}
+}
+
+func TestRunRejectsBadConfig(t *testing.T) {
+	tests := []Config{
+		{Games: 0, Strategies: []string{"greedy", "greedy"}},
+		{Games: 1, Strategies: []string{"greedy"}},
+		{Games: 1, Strategies: strings.Split(strings.Repeat("greedy,", 8)+"greedy", ",")},
+		{Games: 1, Strategies: []string{"greedy", "genius"}},
+	}
+	for _, cfg := range tests {
+		if _, err := Run(cfg); err == nil {
+			t.Fatalf("expected an error for %+v", cfg)
+		}
+	}
+}
@@ -1,35 +1,55 @@
 package tienlen
 
 import (
+	crand "crypto/rand"
+	"encoding/binary"
 	"math/rand"
 	"sort"
+	"time"
 )
 
 // Card is the domain representation of a Tien Len card.
 type Card struct {
-	Suit int32
-	Rank int32
+	Suit int32 `json:"suit"`
+	Rank int32 `json:"rank"`
 }
 
 // NewDeck returns a sorted 52-card deck.
 func NewDeck() []Card {
-	deck := make([]Card, 0, 52)
+	return NewDecks(1)
+}
+
+// NewDecks returns n sorted 52-card decks combined, so every card appears n times.
+func NewDecks(n int) []Card {
+	deck := make([]Card, 0, 52*n)
 	for r := int32(0); r <= 12; r++ {
 		for s := int32(0); s <= 3; s++ {
-			deck = append(deck, Card{Rank: r, Suit: s})
+			for i := 0; i < n; i++ {
+				deck = append(deck, Card{Rank: r, Suit: s})
+			}
 		}
 	}
 	return deck
 }
 
-// ShuffleDeck returns a shuffled copy of the given deck.
-func ShuffleDeck(deck []Card) []Card {
+// ShuffleDeck returns a copy of the given deck shuffled with rng.
+func ShuffleDeck(deck []Card, rng *rand.Rand) []Card {
 	out := make([]Card, len(deck))
 	copy(out, deck)
-	rand.Shuffle(len(out), func(i, j int) { out[i], out[j] = out[j], out[i] })
+	rng.Shuffle(len(out), func(i, j int) { out[i], out[j] = out[j], out[i] })
 	return out
 }
 
+// NewSeed returns an unpredictable seed for a game, falling back to the clock
+// if the system's secure random source is unavailable.
+func NewSeed() int64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		return time.Now().UnixNano()
+	}
+	return int64(binary.LittleEndian.Uint64(b[:]))
+}
+
 // SortHand orders a hand by ascending power.
 func SortHand(cards []Card) {
 	sort.Slice(cards, func(i, j int) bool {
@@ -37,6 +57,23 @@ func SortHand(cards []Card) {
 	})
 }
 
+// IndicesOf locates each card of cards in hand, never using the same index twice
+// so identical cards map to distinct positions.
+func IndicesOf(hand []Card, cards []Card) []int {
+	used := make(map[int]bool, len(cards))
+	indices := make([]int, 0, len(cards))
+	for _, c := range cards {
+		for i, h := range hand {
+			if h == c && !used[i] {
+				used[i] = true
+				indices = append(indices, i)
+				break
+			}
+		}
+	}
+	return indices
+}
+
 func cardPower(c Card) int32 {
 	return c.Rank*4 + c.Suit
 }
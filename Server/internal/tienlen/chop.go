@@ -0,0 +1,63 @@
+package tienlen
+
+// ChopOccurred is emitted when a bomb chops 2s (chat heo) or the bomb of another chop.
+// When a chop is itself over-chopped (chat chong) the penalty accumulates: the
+// victim of the latest chop in the chain owes everything chopped so far.
+type ChopOccurred struct {
+	ChopperID string `json:"chopper_id"`
+	VictimID  string `json:"victim_id"`
+	Chopped   []Card `json:"chopped"` // Cards that were on the board
+	Bomb      []Card `json:"bomb"`    // Cards the chopper played
+	Penalty   int    `json:"penalty"` // Accumulated penalty for the whole chain, in rule set units
+	Chain     int    `json:"chain"`   // 1 for a first chop, 2 for an over-chop, and so on
+}
+
+// detectChop reports whether playing bomb on the current board is a chop and
+// records it. Only 2s and the bomb of the latest chop can be chopped; a bomb beating
+// a bomb that was simply led is an ordinary play. It must only be called once the
+// play has been validated.
+func (g *Game) detectChop(playerID string, bomb []Card) (ChopOccurred, bool) {
+	target := Classify(g.Board)
+	if len(g.Board) == 0 || !Classify(bomb).IsBomb() || !(target.IsTwos() || target.IsBomb() && g.chopChainOpen) {
+		g.chopChainOpen = false
+		return ChopOccurred{}, false
+	}
+
+	chop := ChopOccurred{
+		ChopperID: playerID,
+		VictimID:  g.LastActor,
+		Chopped:   append([]Card(nil), g.Board...),
+		Bomb:      append([]Card(nil), bomb...),
+		Penalty:   g.rules.ChopPenalty(g.Board),
+		Chain:     1,
+	}
+
+	// The board is the bomb of the previous chop: the penalty passes on to its chopper.
+	if g.chopChainOpen && len(g.Chops) > 0 {
+		prev := g.Chops[len(g.Chops)-1]
+		chop.Penalty += prev.Penalty
+		chop.Chain = prev.Chain + 1
+		g.Chops[len(g.Chops)-1] = chop
+	} else {
+		g.Chops = append(g.Chops, chop)
+	}
+	g.chopChainOpen = true
+	return chop, true
+}
+
+// twosPenalty values 2s for chopping and leftover penalties: black 2s (Spade, Club)
+// count 1, red 2s (Diamond, Heart) count 2.
+func twosPenalty(cards []Card) int {
+	total := 0
+	for _, c := range cards {
+		if c.Rank != rankTwo {
+			continue
+		}
+		if c.Suit == 2 || c.Suit == 3 {
+			total += 2
+		} else {
+			total++
+		}
+	}
+	return total
+}
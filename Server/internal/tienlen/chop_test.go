@@ -0,0 +1,123 @@
+package tienlen
+
+import "testing"
+
+func TestChainedChopsAccumulatePenalty(t *testing.T) {
+	pine3 := []Card{{Rank: 0, Suit: 0}, {Rank: 0, Suit: 1}, {Rank: 1, Suit: 0}, {Rank: 1, Suit: 1}, {Rank: 2, Suit: 0}, {Rank: 2, Suit: 1}}
+	quad := []Card{{Rank: 7, Suit: 0}, {Rank: 7, Suit: 1}, {Rank: 7, Suit: 2}, {Rank: 7, Suit: 3}}
+	g := setupDeterministicGame([]string{"p1", "p2", "p3"}, "p1", map[string][]Card{
+		"p1": {{Rank: 12, Suit: 3}, {Rank: 9, Suit: 0}},
+		"p2": append(append([]Card(nil), pine3...), Card{Rank: 10, Suit: 0}),
+		"p3": append(append([]Card(nil), quad...), Card{Rank: 11, Suit: 0}),
+	})
+
+	// p1 leads with the red 2 (heo do).
+	if _, err := g.PlayCards("p1", []int{1}); err != nil {
+		t.Fatalf("p1 PlayCards error: %v", err)
+	}
+
+	// p2 chops it with the 3-pine.
+	events, err := g.PlayCards("p2", []int{0, 1, 2, 3, 4, 5})
+	if err != nil {
+		t.Fatalf("p2 PlayCards error: %v", err)
+	}
+	chop := findChop(events)
+	if chop == nil {
+		t.Fatalf("expected ChopOccurred when a 3-pine chops a 2")
+	}
+	if chop.ChopperID != "p2" || chop.VictimID != "p1" || chop.Penalty != 2 || chop.Chain != 1 {
+		t.Fatalf("unexpected first chop: %+v", chop)
+	}
+
+	// p3 over-chops with the quad: p2 now owes the 2 plus the 3-pine.
+	events, err = g.PlayCards("p3", []int{0, 1, 2, 3})
+	if err != nil {
+		t.Fatalf("p3 PlayCards error: %v", err)
+	}
+	chop = findChop(events)
+	if chop == nil {
+		t.Fatalf("expected ChopOccurred when a quad over-chops a 3-pine")
+	}
+	if chop.ChopperID != "p3" || chop.VictimID != "p2" || chop.Penalty != 5 || chop.Chain != 2 {
+		t.Fatalf("unexpected over-chop: %+v", chop)
+	}
+	if len(g.Chops) != 1 || g.Chops[0].VictimID != "p2" || g.Chops[0].Penalty != 5 {
+		t.Fatalf("expected chain collapsed into a single chop owed by p2, got %+v", g.Chops)
+	}
+}
+
+func TestBombOnFreshBoardIsNotAChop(t *testing.T) {
+	quad := []Card{{Rank: 7, Suit: 0}, {Rank: 7, Suit: 1}, {Rank: 7, Suit: 2}, {Rank: 7, Suit: 3}}
+	g := setupDeterministicGame([]string{"p1", "p2"}, "p1", map[string][]Card{
+		"p1": append(append([]Card(nil), quad...), Card{Rank: 0, Suit: 0}),
+		"p2": {{Rank: 1, Suit: 0}},
+	})
+
+	events, err := g.PlayCards("p1", []int{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("PlayCards error: %v", err)
+	}
+	if findChop(events) != nil || len(g.Chops) != 0 {
+		t.Fatalf("leading with a quad should not be recorded as a chop")
+	}
+}
+
+func TestBombOverLedBombIsNotAChop(t *testing.T) {
+	low := []Card{{Rank: 3, Suit: 0}, {Rank: 3, Suit: 1}, {Rank: 3, Suit: 2}, {Rank: 3, Suit: 3}}
+	high := []Card{{Rank: 7, Suit: 0}, {Rank: 7, Suit: 1}, {Rank: 7, Suit: 2}, {Rank: 7, Suit: 3}}
+	g := setupDeterministicGame([]string{"p1", "p2"}, "p1", map[string][]Card{
+		"p1": append(append([]Card(nil), low...), Card{Rank: 10, Suit: 0}),
+		"p2": append(append([]Card(nil), high...), Card{Rank: 11, Suit: 0}),
+	})
+
+	// p1 leads a quad on the empty table and p2 beats it with a higher quad.
+	if _, err := g.PlayCards("p1", []int{0, 1, 2, 3}); err != nil {
+		t.Fatalf("p1 PlayCards error: %v", err)
+	}
+	events, err := g.PlayCards("p2", []int{0, 1, 2, 3})
+	if err != nil {
+		t.Fatalf("p2 PlayCards error: %v", err)
+	}
+	if findChop(events) != nil || len(g.Chops) != 0 {
+		t.Fatalf("a quad beating a led quad should not be recorded as a chop, got %+v", g.Chops)
+	}
+	for _, r := range g.Settle().Results {
+		if r.ChopPoints != 0 {
+			t.Fatalf("expected no chop points, got %+v", r)
+		}
+	}
+}
+
+func TestChopPenalty(t *testing.T) {
+	pine4 := []Card{
+		{Rank: 0, Suit: 0}, {Rank: 0, Suit: 1}, {Rank: 1, Suit: 0}, {Rank: 1, Suit: 1},
+		{Rank: 2, Suit: 0}, {Rank: 2, Suit: 1}, {Rank: 3, Suit: 0}, {Rank: 3, Suit: 1}}
+	tests := []struct {
+		name   string
+		rules  RuleSet
+		target []Card
+		want   int
+	}{
+		{"black 2", SouthernRules{}, []Card{{Rank: 12, Suit: 0}}, 1},
+		{"red 2", SouthernRules{}, []Card{{Rank: 12, Suit: 2}}, 2},
+		{"mixed pair of 2s", SouthernRules{}, []Card{{Rank: 12, Suit: 1}, {Rank: 12, Suit: 3}}, 3},
+		{"quad", SouthernRules{}, []Card{{Rank: 4, Suit: 0}, {Rank: 4, Suit: 1}, {Rank: 4, Suit: 2}, {Rank: 4, Suit: 3}}, 4},
+		{"4-pine", SouthernRules{}, pine4, 6},
+		{"northern red 2", NorthernRules{}, []Card{{Rank: 12, Suit: 3}}, 2},
+		{"not chop-able", SouthernRules{}, []Card{{Rank: 5, Suit: 0}}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.rules.ChopPenalty(tt.target); got != tt.want {
+			t.Errorf("%s: ChopPenalty() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func findChop(events []Event) *ChopOccurred {
+	for _, ev := range events {
+		if chop, ok := ev.(ChopOccurred); ok {
+			return &chop
+		}
+	}
+	return nil
+}
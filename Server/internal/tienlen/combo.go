@@ -0,0 +1,130 @@
+package tienlen
+
+// ComboKind identifies the shape of a played set of cards.
+type ComboKind int
+
+const (
+	ComboInvalid ComboKind = iota
+	ComboSingle
+	ComboPair
+	ComboTriple
+	ComboQuad
+	ComboStraight         // sanh: >= 3 consecutive ranks, no 2s
+	ComboConsecutivePairs // doi thong: >= 3 consecutive pairs, no 2s
+	ComboOfAKind          // 5 to 8 cards of one rank, only possible with two decks
+)
+
+// maxOfAKind is the most cards of one rank in play: eight, with two decks.
+const maxOfAKind = 8
+
+func (k ComboKind) String() string {
+	switch k {
+	case ComboSingle:
+		return "single"
+	case ComboPair:
+		return "pair"
+	case ComboTriple:
+		return "triple"
+	case ComboQuad:
+		return "quad"
+	case ComboStraight:
+		return "straight"
+	case ComboConsecutivePairs:
+		return "consecutive_pairs"
+	case ComboOfAKind:
+		return "of_a_kind"
+	default:
+		return "invalid"
+	}
+}
+
+// rankTwo is the rank value of the 2 (heo), the highest rank in Tien Len.
+const rankTwo int32 = 12
+
+// Combo is the classified form of a set of cards.
+// Length is the number of cards and Top is the highest-powered card,
+// which decides comparisons between combos of the same kind and length.
+type Combo struct {
+	Kind   ComboKind
+	Length int
+	Top    Card
+}
+
+// Classify determines which Tien Len combination the cards form.
+// Cards that do not form a legal combination yield a Combo of kind ComboInvalid.
+func Classify(cards []Card) Combo {
+	if len(cards) == 0 {
+		return Combo{Kind: ComboInvalid}
+	}
+	combo := Combo{Length: len(cards), Top: topCard(cards)}
+
+	switch {
+	case len(cards) == 1:
+		combo.Kind = ComboSingle
+	case allSameRank(cards) && len(cards) <= 4:
+		combo.Kind = sameRankKinds[len(cards)]
+	case allSameRank(cards) && len(cards) <= maxOfAKind:
+		combo.Kind = ComboOfAKind
+	case isStraight(cards):
+		combo.Kind = ComboStraight
+	case isConsecutivePairs(cards):
+		combo.Kind = ComboConsecutivePairs
+	default:
+		combo.Kind = ComboInvalid
+	}
+	return combo
+}
+
+var sameRankKinds = map[int]ComboKind{
+	2: ComboPair,
+	3: ComboTriple,
+	4: ComboQuad,
+}
+
+// IsValid reports whether the combo is a legal play.
+func (c Combo) IsValid() bool {
+	return c.Kind != ComboInvalid
+}
+
+// Pairs returns the number of pairs in a consecutive-pairs combo (3 for a 3-pine, etc.).
+func (c Combo) Pairs() int {
+	if c.Kind != ComboConsecutivePairs {
+		return 0
+	}
+	return c.Length / 2
+}
+
+// IsTwos reports whether the combo is made only of 2s (a single 2, pair of 2s or triple 2s).
+func (c Combo) IsTwos() bool {
+	switch c.Kind {
+	case ComboSingle, ComboPair, ComboTriple:
+		return c.Top.Rank == rankTwo
+	}
+	return false
+}
+
+// IsBomb reports whether the combo can chop (quads, 3+ consecutive pairs and 5+ of a kind).
+func (c Combo) IsBomb() bool {
+	return c.Kind == ComboQuad || c.Kind == ComboConsecutivePairs || c.Kind == ComboOfAKind
+}
+
+// SameShape reports whether two combos can be compared directly by their top card.
+func (c Combo) SameShape(other Combo) bool {
+	return c.Kind == other.Kind && c.Length == other.Length
+}
+
+// Beats reports whether c outranks other of the same shape. With two decks the top cards
+// may be identical, in which case neither beats the other.
+func (c Combo) Beats(other Combo) bool {
+	return c.SameShape(other) && cardPower(c.Top) > cardPower(other.Top)
+}
+
+func topCard(cards []Card) Card {
+	top := cards[0]
+	for _, c := range cards[1:] {
+		if cardPower(c) > cardPower(top) {
+			top = c
+		}
+	}
+	return top
+}
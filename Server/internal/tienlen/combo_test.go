@@ -0,0 +1,111 @@
+package tienlen
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name   string
+		cards  []Card
+		kind   ComboKind
+		length int
+		top    Card
+	}{
+		{"single", []Card{{Rank: 4, Suit: 2}}, ComboSingle, 1, Card{Rank: 4, Suit: 2}},
+		{"pair", []Card{{Rank: 2, Suit: 3}, {Rank: 2, Suit: 0}}, ComboPair, 2, Card{Rank: 2, Suit: 3}},
+		{"triple", []Card{{Rank: 5, Suit: 0}, {Rank: 5, Suit: 2}, {Rank: 5, Suit: 1}}, ComboTriple, 3, Card{Rank: 5, Suit: 2}},
+		{"quad", []Card{{Rank: 9, Suit: 0}, {Rank: 9, Suit: 1}, {Rank: 9, Suit: 2}, {Rank: 9, Suit: 3}}, ComboQuad, 4, Card{Rank: 9, Suit: 3}},
+		{"straight_unordered", []Card{{Rank: 2, Suit: 0}, {Rank: 0, Suit: 3}, {Rank: 1, Suit: 1}}, ComboStraight, 3, Card{Rank: 2, Suit: 0}},
+		{"consecutive_pairs", []Card{{Rank: 0, Suit: 0}, {Rank: 0, Suit: 1}, {Rank: 1, Suit: 0}, {Rank: 1, Suit: 1}, {Rank: 2, Suit: 2}, {Rank: 2, Suit: 3}}, ComboConsecutivePairs, 6, Card{Rank: 2, Suit: 3}},
+		{"straight_with_two", []Card{{Rank: 10, Suit: 0}, {Rank: 11, Suit: 1}, {Rank: 12, Suit: 2}}, ComboInvalid, 3, Card{Rank: 12, Suit: 2}},
+		{"mismatched_pair", []Card{{Rank: 1, Suit: 0}, {Rank: 2, Suit: 0}}, ComboInvalid, 2, Card{Rank: 2, Suit: 0}},
+	}
+
+	for _, tt := range tests {
+		got := Classify(tt.cards)
+		if got.Kind != tt.kind {
+			t.Errorf("%s: kind = %v, want %v", tt.name, got.Kind, tt.kind)
+			continue
+		}
+		if got.Length != tt.length {
+			t.Errorf("%s: length = %d, want %d", tt.name, got.Length, tt.length)
+		}
+		if got.Top != tt.top {
+			t.Errorf("%s: top = %+v, want %+v", tt.name, got.Top, tt.top)
+		}
+	}
+
+	if Classify(nil).IsValid() {
+		t.Errorf("empty selection should not classify as a valid combo")
+	}
+}
+
+func TestComboPairsAndBombs(t *testing.T) {
+	pine4 := Classify([]Card{
+		{Rank: 3, Suit: 0}, {Rank: 3, Suit: 1}, {Rank: 4, Suit: 0}, {Rank: 4, Suit: 1},
+		{Rank: 5, Suit: 0}, {Rank: 5, Suit: 1}, {Rank: 6, Suit: 0}, {Rank: 6, Suit: 1}})
+	if pine4.Pairs() != 4 {
+		t.Fatalf("expected 4 pairs, got %d", pine4.Pairs())
+	}
+	if !pine4.IsBomb() {
+		t.Fatalf("expected consecutive pairs to be a bomb")
+	}
+
+	pair2 := Classify([]Card{{Rank: 12, Suit: 0}, {Rank: 12, Suit: 3}})
+	if !pair2.IsTwos() {
+		t.Fatalf("expected pair of 2s to be recognised as twos")
+	}
+	if pair2.IsBomb() {
+		t.Fatalf("pair of 2s is not a bomb")
+	}
+}
+
+func TestDoubleDeckCombos(t *testing.T) {
+	ofAKind := func(rank int32, n int) []Card { return sameRankOf(rank, n) }
+
+	heart5 := []Card{{Rank: 2, Suit: 3}}
+	if !CanBeat(heart5, []Card{{Rank: 3, Suit: 0}}) || CanBeat(heart5, heart5) {
+		t.Fatalf("an identical card from the second deck must not beat its twin")
+	}
+	twinPair := []Card{{Rank: 4, Suit: 3}, {Rank: 4, Suit: 3}}
+	if Classify(twinPair).Kind != ComboPair || CanBeat(twinPair, twinPair) {
+		t.Fatalf("identical cards form a pair that does not beat its twin")
+	}
+	if Classify([]Card{{Rank: 4, Suit: 3}, {Rank: 4, Suit: 3}, {Rank: 5, Suit: 0}}).IsValid() {
+		t.Fatalf("a duplicate rank must not form a straight")
+	}
+
+	for n := 5; n <= 8; n++ {
+		combo := Classify(ofAKind(7, n))
+		if combo.Kind != ComboOfAKind || combo.Length != n || !combo.IsBomb() {
+			t.Fatalf("expected %d of a kind to be a bomb, got %+v", n, combo)
+		}
+	}
+	if Classify(ofAKind(7, 9)).IsValid() {
+		t.Fatalf("nine of a kind cannot exist with two decks")
+	}
+
+	pine5 := consecutivePairsOf(5)
+	pairOf2s := []Card{{Rank: 12, Suit: 3}, {Rank: 12, Suit: 3}}
+	for _, rules := range []RuleSet{SouthernRules{}, NorthernRules{}} {
+		tests := []struct {
+			name       string
+			prev, next []Card
+			want       bool
+		}{
+			{"five chop a pair of 2s", pairOf2s, ofAKind(0, 5), true},
+			{"five chop a quad", ofAKind(11, 4), ofAKind(0, 5), true},
+			{"six chop five", ofAKind(11, 5), ofAKind(0, 6), true},
+			{"five do not chop six", ofAKind(0, 6), ofAKind(11, 5), false},
+			{"higher five beat lower five", ofAKind(3, 5), ofAKind(4, 5), true},
+			{"quad does not chop five", ofAKind(3, 5), ofAKind(11, 4), false},
+		}
+		for _, tt := range tests {
+			if got := rules.CanBeat(tt.prev, tt.next); got != tt.want {
+				t.Errorf("%s: %s = %v, want %v", rules.Variant(), tt.name, got, tt.want)
+			}
+		}
+	}
+	if !(SouthernRules{}).CanBeat(pine5, ofAKind(0, 5)) || (SouthernRules{}).CanBeat(ofAKind(0, 5), pine5) {
+		t.Fatalf("five of a kind should outrank every pine")
+	}
+}
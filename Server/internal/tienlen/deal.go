@@ -0,0 +1,139 @@
+package tienlen
+
+import "fmt"
+
+// DefaultHandSize is the number of cards each player is dealt at a four-player table.
+const DefaultHandSize = 13
+
+// smallTable is the player count below which the opening card rule applies.
+const smallTable = 4
+
+// Tables of LargeTable players or more are dealt from two decks, so identical cards can
+// meet: an identical card never beats its twin, and up to eight cards of a kind form a bomb
+// that chops 2s, quads and smaller sets of a kind. MaxPlayers is the largest table.
+const (
+	LargeTable = 5
+	MaxPlayers = 8
+)
+
+// DecksFor returns the number of decks dealt to a table of playerCount players.
+func DecksFor(playerCount int) int {
+	if playerCount >= LargeTable {
+		return 2
+	}
+	return 1
+}
+
+// Direction is the way the deal and the turns travel around the table. Start takes the
+// players in seat order, with seat numbers increasing clockwise.
+type Direction string
+
+const (
+	CounterClockwise Direction = "counter_clockwise" // Traditional; used when none is set
+	Clockwise        Direction = "clockwise"
+)
+
+// DealConfig chooses how the deck is dealt. The zero value deals DefaultHandSize cards each,
+// counter-clockwise.
+//
+// With fewer than four players part of the deck stays undealt, so the lowest card in play
+// is not necessarily 3♠. At such small tables the holder of the lowest dealt card always
+// leads the first round and must include that card, the "opening card", in the first play.
+type DealConfig struct {
+	HandSize  int       `json:"hand_size,omitempty"` // Cards per player; 0 means DefaultHandSize
+	DealAll   bool      `json:"deal_all,omitempty"`  // Share out the whole deck; overrides HandSize
+	Direction Direction `json:"direction,omitempty"` // Empty means CounterClockwise
+}
+
+// direction resolves the way turns travel.
+func (d DealConfig) direction() Direction {
+	if d.Direction == "" {
+		return CounterClockwise
+	}
+	return d.Direction
+}
+
+// turnOrder orders the players, given in seat order, the way turns travel. The first seat
+// always comes first; counter-clockwise play then goes down the seat numbers.
+func (d DealConfig) turnOrder(players []string) ([]string, error) {
+	order := append([]string(nil), players...)
+	switch d.direction() {
+	case Clockwise:
+	case CounterClockwise:
+		for i, j := 1, len(order)-1; i < j; i, j = i+1, j-1 {
+			order[i], order[j] = order[j], order[i]
+		}
+	default:
+		return nil, fmt.Errorf("unknown direction %q", d.Direction)
+	}
+	return order, nil
+}
+
+// handSize resolves the cards per player for the given table size.
+func (d DealConfig) handSize(playerCount int, deckSize int) (int, error) {
+	if playerCount == 0 {
+		return 0, fmt.Errorf("no players to deal to")
+	}
+	if d.DealAll {
+		return deckSize / playerCount, nil
+	}
+	size := d.HandSize
+	if size == 0 {
+		size = DefaultHandSize
+	}
+	if size < 1 || size*playerCount > deckSize {
+		return 0, fmt.Errorf("cannot deal %d cards each to %d players", size, playerCount)
+	}
+	return size, nil
+}
+
+// HandSize returns the number of cards each player was dealt, or 0 before the first deal.
+func (g *Game) HandSize() int {
+	return g.handSize
+}
+
+// OpeningCard returns the card the first play must include, if that play is still to come.
+func (g *Game) OpeningCard() (Card, bool) {
+	if g.openingCard == nil {
+		return Card{}, false
+	}
+	return *g.openingCard, true
+}
+
+// copyCard copies an optional card, such as the opening card, so callers cannot change it.
+func copyCard(c *Card) *Card {
+	if c == nil {
+		return nil
+	}
+	out := *c
+	return &out
+}
+
+// lowestDealt finds the index into turnOrder of the player holding the lowest dealt card.
+func lowestDealt(turnOrder []string, hands map[string][]Card) (int, Card) {
+	idx, lowest := -1, Card{}
+	for i, uid := range turnOrder {
+		hand := hands[uid]
+		if len(hand) == 0 {
+			continue
+		}
+		// Hands are sorted, so the first card is the smallest
+		if idx == -1 || cardPower(hand[0]) < cardPower(lowest) {
+			idx, lowest = i, hand[0]
+		}
+	}
+	return idx, lowest
+}
+
+// includesOpeningCard reports whether cards satisfy the opening card rule.
+func (g *Game) includesOpeningCard(cards []Card) bool {
+	if g.openingCard == nil {
+		return true
+	}
+	for _, c := range cards {
+		if c == *g.openingCard {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+package tienlen
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSmallTableDeals(t *testing.T) {
+	tests := []struct {
+		name     string
+		deal     DealConfig
+		players  []string
+		handSize int
+		wantErr  bool
+	}{
+		{"four players", DealConfig{}, []string{"p1", "p2", "p3", "p4"}, 13, false},
+		{"three players", DealConfig{}, []string{"p1", "p2", "p3"}, 13, false},
+		{"three players deal all", DealConfig{DealAll: true}, []string{"p1", "p2", "p3"}, 17, false},
+		{"two players deal all", DealConfig{DealAll: true}, []string{"p1", "p2"}, 26, false},
+		{"two players of 20", DealConfig{HandSize: 20}, []string{"p1", "p2"}, 20, false},
+		{"too many cards", DealConfig{HandSize: 14}, []string{"p1", "p2", "p3", "p4"}, 0, true},
+		{"negative hand size", DealConfig{HandSize: -1}, []string{"p1", "p2"}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGame()
+			g.Deal = tt.deal
+			_, err := g.StartWithSeed(1, tt.players, "p1", "")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("StartWithSeed error: %v", err)
+			}
+			for uid, hand := range g.Hands {
+				if len(hand) != tt.handSize {
+					t.Fatalf("expected %d cards for %s, got %d", tt.handSize, uid, len(hand))
+				}
+			}
+			snap := g.Snapshot()
+			if snap.PlayerCount != len(tt.players) || snap.HandSize != tt.handSize {
+				t.Fatalf("unexpected snapshot %+v", snap)
+			}
+			if (snap.OpeningCard != nil) != (len(tt.players) < 4) {
+				t.Fatalf("expected an opening card only at small tables, got %v", snap.OpeningCard)
+			}
+		})
+	}
+}
+
+func TestDealAllGoesOnToPlay(t *testing.T) {
+	for _, players := range [][]string{{"p1", "p2"}, {"p1", "p2", "p3"}} {
+		for seed := int64(1); seed <= 50; seed++ {
+			g := NewGame()
+			g.Deal = DealConfig{DealAll: true}
+			events, err := g.StartWithSeed(seed, players, "p1", "")
+			if err != nil {
+				t.Fatalf("StartWithSeed error: %v", err)
+			}
+			if _, ok := events[len(events)-1].(TurnChanged); !ok || !g.IsPlaying() {
+				t.Fatalf("%d players, seed %d: expected the game to go on, got %+v", len(players), seed, events[len(events)-1])
+			}
+		}
+	}
+}
+
+func TestOpeningCardMustLeadFirstPlay(t *testing.T) {
+	g := NewGame()
+	events, err := g.StartWithSeed(1, []string{"p1", "p2", "p3"}, "p1", "")
+	if err != nil {
+		t.Fatalf("StartWithSeed error: %v", err)
+	}
+	opening, ok := g.OpeningCard()
+	if !ok {
+		t.Fatalf("expected an opening card at a three-player table")
+	}
+	if started := events[0].(GameStarted); started.OpeningCard == nil || *started.OpeningCard != opening {
+		t.Fatalf("expected GameStarted to announce the opening card, got %+v", started.OpeningCard)
+	}
+
+	// The leader holds the lowest dealt card, which is not necessarily 3♠.
+	leader := g.TurnOrder[g.CurrentIdx]
+	for _, hand := range g.Hands {
+		if cardPower(hand[0]) < cardPower(opening) {
+			t.Fatalf("opening card %v is not the lowest dealt card", opening)
+		}
+	}
+	if g.Hands[leader][0] != opening {
+		t.Fatalf("expected %s, who holds %v, to lead", leader, opening)
+	}
+
+	hints, _, err := g.Hints(leader, 0)
+	if err != nil {
+		t.Fatalf("Hints error: %v", err)
+	}
+	for _, h := range hints {
+		if len(IndicesOf(h.Cards, []Card{opening})) != 1 {
+			t.Fatalf("hint %v leaves out the opening card", h.Cards)
+		}
+	}
+
+	if _, err := g.PlayCardValues(leader, g.Hands[leader][1:2]); err == nil {
+		t.Fatalf("expected a first play without the opening card to be rejected")
+	}
+	if _, err := g.PlayCardValues(leader, []Card{opening}); err != nil {
+		t.Fatalf("PlayCardValues error: %v", err)
+	}
+	if _, ok := g.OpeningCard(); ok {
+		t.Fatalf("expected the opening card rule to end after the first play")
+	}
+}
+
+func TestPreviousWinnerLeadsSmallTable(t *testing.T) {
+	g := NewGame()
+	if _, err := g.StartWithSeed(1, []string{"p1", "p2", "p3"}, "p1", "p2"); err != nil {
+		t.Fatalf("StartWithSeed error: %v", err)
+	}
+	if g.TurnOrder[g.CurrentIdx] != "p2" {
+		t.Fatalf("expected the previous winner to lead, got %s", g.TurnOrder[g.CurrentIdx])
+	}
+	if _, ok := g.OpeningCard(); ok {
+		t.Fatalf("expected no opening card when the previous winner leads")
+	}
+}
+
+func TestLargeTableUsesTwoDecks(t *testing.T) {
+	players := []string{"p1", "p2", "p3", "p4", "p5", "p6", "p7", "p8"}
+	g := NewGame()
+	if _, err := g.StartWithSeed(1, players, "p1", ""); err != nil {
+		t.Fatalf("StartWithSeed error: %v", err)
+	}
+	if len(g.Deck) != 104 {
+		t.Fatalf("expected a double deck, got %d cards", len(g.Deck))
+	}
+	copies := make(map[Card]int)
+	for _, uid := range players {
+		if len(g.Hands[uid]) != DefaultHandSize {
+			t.Fatalf("expected %d cards for %s, got %d", DefaultHandSize, uid, len(g.Hands[uid]))
+		}
+		for _, c := range g.Hands[uid] {
+			copies[c]++
+		}
+	}
+	for c, n := range copies {
+		if n != 2 {
+			t.Fatalf("expected both copies of %v dealt, got %d", c, n)
+		}
+	}
+
+	if _, err := NewGame().StartWithSeed(1, append(players, "p9"), "p1", ""); err == nil {
+		t.Fatalf("expected more than %d players to be rejected", MaxPlayers)
+	}
+}
+
+func TestTurnOrderFollowsSeats(t *testing.T) {
+	seats := []string{"s0", "s1", "s2", "s3"}
+	tests := []struct {
+		name      string
+		direction Direction
+		want      []string
+		wantErr   bool
+	}{
+		{"default is counter-clockwise", "", []string{"s0", "s3", "s2", "s1"}, false},
+		{"counter-clockwise", CounterClockwise, []string{"s0", "s3", "s2", "s1"}, false},
+		{"clockwise", Clockwise, []string{"s0", "s1", "s2", "s3"}, false},
+		{"unknown", "sideways", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The seed and the previous winner only pick who starts, never the order.
+			for seed := int64(1); seed <= 5; seed++ {
+				g := NewGame()
+				g.Deal.Direction = tt.direction
+				_, err := g.StartWithSeed(seed, seats, "s0", seats[seed%4])
+				if tt.wantErr {
+					if err == nil {
+						t.Fatalf("expected an error")
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("StartWithSeed error: %v", err)
+				}
+				if !reflect.DeepEqual(g.TurnOrder, tt.want) {
+					t.Fatalf("expected turn order %v, got %v", tt.want, g.TurnOrder)
+				}
+				if g.IsPlaying() && g.TurnOrder[g.CurrentIdx] != seats[seed%4] {
+					t.Fatalf("expected the previous winner %s to start, got %s", seats[seed%4], g.TurnOrder[g.CurrentIdx])
+				}
+			}
+
+			// A committed deal verifies in its direction.
+			g := NewGame()
+			g.Deal.Direction = tt.direction
+			if _, err := g.StartCommitted(CommitDeal("server", "client"), seats, "s0", "s0"); err != nil {
+				t.Fatalf("StartCommitted error: %v", err)
+			}
+			if err := VerifyDeal(*g.reveal(), g.HandsCopy()); err != nil {
+				t.Fatalf("VerifyDeal error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package tienlen
+
+import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+// DealCommitment makes a deal provably fair with commit-reveal. The server picks a secret
+// seed and publishes its SeedHash before the players contribute their entropy, so it cannot
+// go on drawing seeds until it likes the deal. When the cards are dealt it publishes
+// Commitment, the hash of the seed together with the entropy. At game over the seed is
+// revealed, so anyone can check it against both hashes and recompute the deal with VerifyDeal.
+type DealCommitment struct {
+	ServerSeed    string `json:"server_seed"`         // Hex-encoded, secret until the game ends
+	SeedHash      string `json:"seed_hash,omitempty"` // Hex-encoded SHA-256 of ServerSeed; public before the entropy
+	ClientEntropy string `json:"client_entropy"`      // Contributed by the players; public from the start
+	Commitment    string `json:"commitment"`          // Hex-encoded SHA-256 of ServerSeed and ClientEntropy
+}
+
+// DealReveal is everything needed to recompute and verify a committed deal.
+type DealReveal struct {
+	DealCommitment
+	Players   []string  // Players in the order they were passed to Start
+	Deck      []Card    // Deck order the hands were dealt from
+	HandSize  int       // Cards dealt to each player; 0 means DefaultHandSize
+	Direction Direction // Way the deal went round; empty means CounterClockwise
+}
+
+// NewServerSeed draws a fresh secret server seed for a committed deal.
+func NewServerSeed() (string, error) {
+	seed := make([]byte, 32)
+	if _, err := crand.Read(seed); err != nil {
+		return "", fmt.Errorf("generating server seed: %w", err)
+	}
+	return hex.EncodeToString(seed), nil
+}
+
+// SeedHash returns the hash to publish for serverSeed before any entropy is taken.
+func SeedHash(serverSeed string) string {
+	sum := sha256.Sum256([]byte(serverSeed))
+	return hex.EncodeToString(sum[:])
+}
+
+// CommitDeal commits to serverSeed together with the players' entropy.
+func CommitDeal(serverSeed, clientEntropy string) DealCommitment {
+	return DealCommitment{
+		ServerSeed:    serverSeed,
+		SeedHash:      SeedHash(serverSeed),
+		ClientEntropy: clientEntropy,
+		Commitment:    commitmentOf(serverSeed, clientEntropy),
+	}
+}
+
+// Seed derives the game seed from the server seed and the client entropy.
+func (c DealCommitment) Seed() int64 {
+	sum := sha256.Sum256([]byte("deal:" + c.ServerSeed + ":" + c.ClientEntropy))
+	return int64(binary.BigEndian.Uint64(sum[:8]))
+}
+
+func commitmentOf(serverSeed, clientEntropy string) string {
+	sum := sha256.Sum256([]byte(serverSeed + ":" + clientEntropy))
+	return hex.EncodeToString(sum[:])
+}
+
+// StartCommitted starts the game with a seed derived from the commitment. GameStarted
+// carries the commitment and GameOver reveals the seed and deck order.
+func (g *Game) StartCommitted(c DealCommitment, players []string, ownerID string, lastWinnerID string) ([]Event, error) {
+	g.commitment = &c
+	return g.StartWithSeed(c.Seed(), players, ownerID, lastWinnerID)
+}
+
+// reveal returns the reveal of a committed deal, or nil if the deal was not committed.
+func (g *Game) reveal() *DealReveal {
+	if g.commitment == nil {
+		return nil
+	}
+	return &DealReveal{
+		DealCommitment: *g.commitment,
+		Players:        append([]string(nil), g.players...),
+		Deck:           append([]Card(nil), g.Deck...),
+		HandSize:       g.handSize,
+		Direction:      g.Deal.direction(),
+	}
+}
+
+// VerifyDeal checks a revealed deal: the seed must match the seed hash, if given, and the
+// commitment, dealing from it must reproduce the revealed deck order, and every hand given
+// must be the one dealt to that player. Pass the hands you know, e.g. your own or those
+// shown at game over, and check SeedHash against the one published before the deal.
+func VerifyDeal(r DealReveal, hands map[string][]Card) error {
+	if r.SeedHash != "" && SeedHash(r.ServerSeed) != r.SeedHash {
+		return errors.New("server seed does not match the seed hash")
+	}
+	if commitmentOf(r.ServerSeed, r.ClientEntropy) != r.Commitment {
+		return errors.New("server seed does not match the commitment")
+	}
+	if len(r.Players) == 0 {
+		return errors.New("no players in reveal")
+	}
+
+	turnOrder, err := DealConfig{Direction: r.Direction}.turnOrder(r.Players)
+	if err != nil {
+		return err
+	}
+	deck := shuffleDeal(rand.New(rand.NewSource(r.Seed())), len(r.Players))
+	if len(deck) != len(r.Deck) {
+		return errors.New("deck order does not match the seed")
+	}
+	for i := range deck {
+		if deck[i] != r.Deck[i] {
+			return errors.New("deck order does not match the seed")
+		}
+	}
+
+	handSize := r.HandSize
+	if handSize == 0 {
+		handSize = DefaultHandSize
+	}
+	dealt, err := dealHands(turnOrder, deck, handSize)
+	if err != nil {
+		return err
+	}
+	for uid, hand := range hands {
+		want, ok := dealt[uid]
+		if !ok {
+			return fmt.Errorf("player %s was not dealt in", uid)
+		}
+		got := append([]Card(nil), hand...)
+		SortHand(got)
+		if len(got) != len(want) {
+			return fmt.Errorf("hand of %s does not match the deal", uid)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				return fmt.Errorf("hand of %s does not match the deal", uid)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package tienlen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommittedDealCanBeVerified(t *testing.T) {
+	a, errA := NewServerSeed()
+	b, errB := NewServerSeed()
+	if errA != nil || errB != nil || len(a) != 64 || a == b {
+		t.Fatalf("expected distinct 32-byte hex server seeds, got %q, %q (%v, %v)", a, b, errA, errB)
+	}
+
+	// A fixed seed keeps the deal free of instant wins, so the game can be played out.
+	serverSeed := strings.Repeat("ab", 32)
+	commitment := CommitDeal(serverSeed, "p1=abc,p2=xyz")
+
+	g := NewGame()
+	events, err := g.StartCommitted(commitment, []string{"p1", "p2"}, "p1", "")
+	if err != nil {
+		t.Fatalf("StartCommitted error: %v", err)
+	}
+	started := events[0].(GameStarted)
+	if started.Commitment != commitment.Commitment || started.ClientEntropy != "p1=abc,p2=xyz" {
+		t.Fatalf("expected the commitment in GameStarted, got %+v", started)
+	}
+	if strings.Contains(started.Commitment, serverSeed) {
+		t.Fatalf("commitment must not reveal the server seed")
+	}
+
+	// Finish the game quickly: the leader holds a single card.
+	leader := g.TurnOrder[g.CurrentIdx]
+	g.Hands[leader] = g.Hands[leader][:1]
+	events, err = g.PlayCards(leader, []int{0})
+	if err != nil {
+		t.Fatalf("PlayCards error: %v", err)
+	}
+	var gameOver *GameOver
+	processEvents(events, &gameOver, new([]PlayerFinished))
+	if gameOver == nil || gameOver.Reveal == nil {
+		t.Fatalf("expected GameOver to reveal the deal, got %+v", events)
+	}
+	reveal := *gameOver.Reveal
+	if reveal.ServerSeed != serverSeed || reveal.SeedHash != SeedHash(serverSeed) || len(reveal.Deck) != 52 {
+		t.Fatalf("unexpected reveal: %+v", reveal)
+	}
+
+	if err := VerifyDeal(reveal, started.Hands); err != nil {
+		t.Fatalf("VerifyDeal rejected an honest deal: %v", err)
+	}
+
+	tampered := reveal
+	tampered.ServerSeed = strings.Repeat("0", 64)
+	if err := VerifyDeal(tampered, started.Hands); err == nil {
+		t.Fatalf("expected a different server seed to fail the commitment check")
+	}
+
+	tampered = reveal
+	tampered.SeedHash = SeedHash("another seed")
+	if err := VerifyDeal(tampered, started.Hands); err == nil {
+		t.Fatalf("expected a seed other than the announced one to fail verification")
+	}
+
+	tampered = reveal
+	tampered.Deck = append([]Card(nil), reveal.Deck...)
+	tampered.Deck[0], tampered.Deck[51] = tampered.Deck[51], tampered.Deck[0]
+	if err := VerifyDeal(tampered, started.Hands); err == nil {
+		t.Fatalf("expected a reordered deck to fail verification")
+	}
+
+	swapped := map[string][]Card{"p1": started.Hands["p2"]}
+	if err := VerifyDeal(reveal, swapped); err == nil {
+		t.Fatalf("expected another player's hand to fail verification")
+	}
+}
+
+func TestUncommittedDealHasNoReveal(t *testing.T) {
+	g := setupDeterministicGame([]string{"p1", "p2"}, "p1", map[string][]Card{
+		"p1": {{Rank: 0, Suit: 0}},
+		"p2": {{Rank: 1, Suit: 0}},
+	})
+	events, err := g.PlayCards("p1", []int{0})
+	if err != nil {
+		t.Fatalf("PlayCards error: %v", err)
+	}
+	var gameOver *GameOver
+	processEvents(events, &gameOver, new([]PlayerFinished))
+	if gameOver == nil || gameOver.Reveal != nil {
+		t.Fatalf("expected GameOver without a reveal, got %+v", gameOver)
+	}
+}
@@ -6,18 +6,34 @@ import (
 	"math/rand"
 )
 
+// InitialHandVersion is the version of a freshly dealt hand.
+const InitialHandVersion = 1
+
 // Event marker interface implemented by all domain events.
 type Event interface{}
 
 type GameStarted struct {
-	Hands     map[string][]Card
+	Hands map[string][]Card
+	// Players lists the players in seat order, as given to Start; TurnOrder lists them in
+	// the order they take turns.
+	Players   []string
 	TurnOrder []string
 	OwnerID   string
+
+	// Commitment and ClientEntropy are set when the deal is committed (see StartCommitted).
+	Commitment    string
+	ClientEntropy string
+
+	// HandSize is the number of cards dealt to each player. OpeningCard is set at small
+	// tables, where the first play must include it (see DealConfig).
+	HandSize    int
+	OpeningCard *Card
 }
 
 type HandUpdated struct {
 	PlayerID string
 	Hand     []Card
+	Version  int
 }
 
 type TurnChanged struct {
@@ -25,6 +41,13 @@ type TurnChanged struct {
 	Board          []Card
 }
 
+// TurnTimedOut is emitted when a player's turn clock runs out and the server moves for them:
+// a pass, or the lowest single when they lead the round (Cards is then set).
+type TurnTimedOut struct {
+	PlayerID string
+	Cards    []Card
+}
+
 type RoundEnded struct {
 	WinnerID string
 }
@@ -36,8 +59,20 @@ type PlayerFinished struct {
 	Rank     int // 1st, 2nd, 3rd
 }
 
+// InstantWin is emitted when a dealt hand wins on the spot (toi trang).
+// Hand is the winning hand, revealed to the table.
+type InstantWin struct {
+	PlayerID string
+	Pattern  InstantWinPattern
+	Hand     []Card
+}
+
+// GameOver is emitted when the game ends. Settlement carries the full standings and points.
+// Reveal is set when the deal was committed, so players can verify it.
 type GameOver struct {
-	WinnerID string
+	WinnerID   string
+	Settlement Settlement
+	Reveal     *DealReveal
 }
 
 // Snapshot captures lightweight game state for late joiners.
@@ -46,9 +81,14 @@ type Snapshot struct {
 	OwnerID         string
 	Board           []Card
 	ActivePlayerID  string
-	PlayerIDs       []string // Seat/turn order as assigned by the match
+	PlayerIDs       []string // Players in the order they take turns
 	Winners         []string
 	FinishedPlayers map[string]bool
+	Variant         Variant
+	PlayerCount     int   // Players dealt in; fewer than 4 is a small table (see DealConfig)
+	HandSize        int   // Cards dealt to each player
+	OpeningCard     *Card // Card the first play must include, until it is played
+	Direction       Direction
 }
 
 // Game contains pure Tien Len state and rules.
@@ -61,6 +101,32 @@ type Game struct {
 	RoundSkippers map[string]bool
 	OwnerID       string
 	isPlaying     bool
+	rules         RuleSet
+
+	// Seed drives every random choice of the game, which is the order of the deck, so a
+	// seed together with the players and the list of moves reproduces the game exactly.
+	Seed int64
+	rng  *rand.Rand
+
+	// Deck is the shuffled deck the hands were dealt from; players lists the players
+	// in the order given to Start. Together with Seed they let a deal be verified.
+	Deck    []Card
+	players []string
+
+	// commitment is set for committed deals; its server seed stays secret until game over.
+	commitment *DealCommitment
+
+	// Deal configures how the next Start deals the deck. handSize is the resulting number
+	// of cards per player and openingCard the card the first play must include, if any.
+	Deal        DealConfig
+	handSize    int
+	openingCard *Card
+
+	// log records every accepted command, see Log and Replay.
+	log []LogEntry
+
+	// abandoned is the set of players who left mid-game, see Abandon.
+	abandoned map[string]bool
 
 	// Winners tracks the players who have finished their hands, in order of finishing.
 	// Winners[0] is the 1st place winner, Winners[1] is 2nd, etc.
@@ -69,18 +135,51 @@ type Game struct {
 	// FinishedPlayers is a set of userIDs for players who have emptied their hands.
 	// Used to skip these players during turn advancement.
 	FinishedPlayers map[string]bool
+
+	// Chops lists every chop of the game. A chain of over-chops is collapsed into
+	// its latest chop, which carries the accumulated penalty.
+	Chops []ChopOccurred
+
+	// CardsPlayed counts the cards each player has played, used to detect cong at settlement.
+	CardsPlayed map[string]int
+
+	// HandVersions counts the changes to each hand, starting at InitialHandVersion when
+	// dealt, so a client can prove which hand its card indices refer to.
+	HandVersions map[string]int
+
+	// endedByInstantWin is set when a dealt hand won on the spot (toi trang).
+	endedByInstantWin bool
+
+	// chopChainOpen is true while the board holds the bomb of the latest chop,
+	// so a higher bomb would over-chop it.
+	chopChainOpen bool
 }
 
+// NewGame creates a game played with the default (Southern) rules.
 func NewGame() *Game {
+	return NewGameWithRules(SouthernRules{})
+}
+
+// NewGameWithRules creates a game played with the given rule set.
+func NewGameWithRules(rules RuleSet) *Game {
 	return &Game{
+		rules:           rules,
 		Hands:           make(map[string][]Card),
 		RoundSkippers:   make(map[string]bool),
 		CurrentIdx:      0,
-		Winners:         make([]string, 0, 3), // Max 3 winners in a 4-player game
+		Winners:         make([]string, 0, MaxPlayers-1),
 		FinishedPlayers: make(map[string]bool),
+		CardsPlayed:     make(map[string]int),
+		HandVersions:    make(map[string]int),
+		abandoned:       make(map[string]bool),
 	}
 }
 
+// Rules returns the rule set the game is played with.
+func (g *Game) Rules() RuleSet {
+	return g.rules
+}
+
 func (g *Game) IsPlaying() bool {
 	return g.isPlaying
 }
@@ -97,6 +196,11 @@ func (g *Game) HandOf(userID string) []Card {
 	return out
 }
 
+// HandVersion returns the current version of the player's hand.
+func (g *Game) HandVersion(userID string) int {
+	return g.HandVersions[userID]
+}
+
 func (g *Game) Snapshot() Snapshot {
 	activeID := ""
 	if len(g.TurnOrder) > 0 && g.CurrentIdx >= 0 && g.CurrentIdx < len(g.TurnOrder) {
@@ -119,91 +223,193 @@ func (g *Game) Snapshot() Snapshot {
 		PlayerIDs:       playerIDs,
 		Winners:         append([]string(nil), g.Winners...), // Create a copy of Winners slice
 		FinishedPlayers: finishedPlayersCopy,
+		Variant:         g.rules.Variant(),
+		PlayerCount:     len(g.TurnOrder),
+		HandSize:        g.handSize,
+		OpeningCard:     copyCard(g.openingCard),
+		Direction:       g.Deal.direction(),
 	}
 }
 
-// Start initializes the game with the given players.
-// It determines the starting player based on 'lastWinnerID' (winner of previous game).
-// If 'lastWinnerID' is empty or not in the game, the player with the smallest card (lowest power) starts.
+// Start initializes the game with the given players, dealing from a fresh random seed.
+// Players are given in seat order and take turns in the direction set by Deal.
+// The rule set picks the starting player; all variants let 'lastWinnerID' (winner of the previous game)
+// lead and otherwise fall back to the player with the smallest card (lowest power).
 func (g *Game) Start(players []string, ownerID string, lastWinnerID string) ([]Event, error) {
+	return g.StartWithSeed(NewSeed(), players, ownerID, lastWinnerID)
+}
+
+// StartWithSeed is Start driven by the given seed: the same seed and players always
+// produce the same hands.
+func (g *Game) StartWithSeed(seed int64, players []string, ownerID string, lastWinnerID string) ([]Event, error) {
 	if len(players) == 0 {
 		return nil, errors.New("no players provided")
 	}
+	if len(players) > MaxPlayers {
+		return nil, fmt.Errorf("at most %d players can play", MaxPlayers)
+	}
+	turnOrder, err := g.Deal.turnOrder(players)
+	if err != nil {
+		return nil, err
+	}
 	g.OwnerID = ownerID
+	g.Seed = seed
+	g.rng = rand.New(rand.NewSource(seed))
+	g.players = append([]string(nil), players...)
 
-	turnOrder := make([]string, len(players))
-	copy(turnOrder, players)
-	rand.Shuffle(len(turnOrder), func(i, j int) { turnOrder[i], turnOrder[j] = turnOrder[j], turnOrder[i] })
+	deck := shuffleDeal(g.rng, len(players))
+	handSize, err := g.Deal.handSize(len(players), len(deck))
+	if err != nil {
+		return nil, err
+	}
+	hands, err := dealHands(turnOrder, deck, handSize)
+	if err != nil {
+		return nil, err
+	}
 	g.TurnOrder = turnOrder
+	g.Deck = deck
+	g.Hands = hands
+	g.handSize = handSize
+	g.HandVersions = make(map[string]int, len(hands))
+	for uid := range hands {
+		g.HandVersions[uid] = InitialHandVersion
+	}
+	g.record(LogEntry{
+		Kind:         CommandStart,
+		Variant:      g.rules.Variant(),
+		Seed:         seed,
+		Players:      append([]string(nil), players...),
+		OwnerID:      ownerID,
+		LastWinnerID: lastWinnerID,
+		Commitment:   g.commitment,
+		Deal:         g.Deal,
+	})
+
+	// Determine starting player (last winner, else lowest card) per the rule set.
+	startIndex := g.rules.StartingIndex(g.TurnOrder, g.Hands, lastWinnerID)
+
+	// At a small table the lowest dealt card opens the game, unless the previous winner leads.
+	g.openingCard = nil
+	if len(turnOrder) < smallTable && !g.HasPlayer(lastWinnerID) {
+		idx, lowest := lowestDealt(turnOrder, hands)
+		startIndex = idx
+		g.openingCard = &lowest
+	}
+
+	g.CurrentIdx = startIndex
+	g.Board = nil
+	g.RoundSkippers = make(map[string]bool)
+	g.LastActor = ""
+	g.isPlaying = true
+
+	started := GameStarted{
+		Hands:       g.HandsCopy(),
+		Players:     append([]string(nil), players...),
+		TurnOrder:   turnOrder,
+		OwnerID:     g.OwnerID,
+		HandSize:    handSize,
+		OpeningCard: copyCard(g.openingCard),
+	}
+	if g.commitment != nil {
+		started.Commitment = g.commitment.Commitment
+		started.ClientEntropy = g.commitment.ClientEntropy
+	}
+	events := []Event{started}
+
+	if win, ok := g.detectInstantWin(); ok {
+		g.isPlaying = false
+		g.endedByInstantWin = true
+		g.Winners = append(g.Winners, win.PlayerID)
+		events = append(events, win, g.gameOver())
+		return events, nil
+	}
+
+	events = append(events,
+		TurnChanged{
+			ActivePlayerID: turnOrder[g.CurrentIdx],
+			Board:          g.Board,
+		},
+	)
+	return events, nil
+}
 
-	deck := ShuffleDeck(NewDeck())
-	handSize := 13
+// shuffleDeal draws the deck order for playerCount players from rng.
+func shuffleDeal(rng *rand.Rand, playerCount int) []Card {
+	return ShuffleDeck(NewDecks(DecksFor(playerCount)), rng)
+}
+
+// dealHands hands out consecutive blocks of handSize cards in turn order. Cards past the
+// last block stay undealt.
+func dealHands(turnOrder []string, deck []Card, handSize int) (map[string][]Card, error) {
 	if len(deck) < len(turnOrder)*handSize {
 		return nil, fmt.Errorf("not enough cards for %d players", len(turnOrder))
 	}
 
-	g.Hands = make(map[string][]Card, len(turnOrder))
+	hands := make(map[string][]Card, len(turnOrder))
 	for i, uid := range turnOrder {
 		start := i * handSize
 		end := start + handSize
 		hand := append([]Card(nil), deck[start:end]...)
 		SortHand(hand)
-		g.Hands[uid] = hand
+		hands[uid] = hand
 	}
+	return hands, nil
+}
 
-	// Determine starting player
-	startIndex := -1
+// gameOver builds the GameOver event for a finished game.
+func (g *Game) gameOver() GameOver {
+	return GameOver{WinnerID: g.Winners[0], Settlement: g.Settle(), Reveal: g.reveal()}
+}
 
-	// 1. Try last winner
-	if lastWinnerID != "" {
-		for i, uid := range g.TurnOrder {
-			if uid == lastWinnerID {
-				startIndex = i
-				break
-			}
-		}
+// detectInstantWin checks every dealt hand against the rule set's instant-win patterns.
+// Hands are checked in turn order beginning with the starting player, so when several
+// players qualify the one who would have led wins. The patterns are rare only in hands of
+// DefaultHandSize; larger hands, such as those of DealAll, nearly always hold one, so other
+// hand sizes never win on the spot.
+func (g *Game) detectInstantWin() (InstantWin, bool) {
+	if g.handSize != DefaultHandSize {
+		return InstantWin{}, false
 	}
-
-	// 2. Fallback to smallest card (if no last winner or last winner left)
-	if startIndex == -1 {
-		lowestPower := int32(1000) // Start high
-		lowestPlayerIndex := 0
-
-		for i, uid := range g.TurnOrder {
-			hand := g.Hands[uid]
-			if len(hand) > 0 {
-				// Hands are sorted, so the first card is the smallest
-				power := cardPower(hand[0])
-				if power < lowestPower {
-					lowestPower = power
-					lowestPlayerIndex = i
-				}
-			}
+	count := len(g.TurnOrder)
+	decks := DecksFor(count)
+	for i := 0; i < count; i++ {
+		uid := g.TurnOrder[(g.CurrentIdx+i)%count]
+		if pattern, ok := g.rules.InstantWin(g.Hands[uid], decks); ok {
+			return InstantWin{PlayerID: uid, Pattern: pattern, Hand: g.HandOf(uid)}, true
 		}
-		startIndex = lowestPlayerIndex
 	}
+	return InstantWin{}, false
+}
 
-	g.CurrentIdx = startIndex
-	g.Board = nil
-	g.RoundSkippers = make(map[string]bool)
-	g.LastActor = ""
-	g.isPlaying = true
+// PlayCardValues plays the given cards, identified by value rather than by position, after
+// checking that the player holds every one of them.
+func (g *Game) PlayCardValues(playerID string, cards []Card) ([]Event, error) {
+	if len(cards) == 0 {
+		return nil, errors.New("no cards selected")
+	}
+	indices := IndicesOf(g.Hands[playerID], cards)
+	if len(indices) != len(cards) {
+		return nil, errors.New("card not in hand")
+	}
+	return g.PlayCards(playerID, indices)
+}
 
-	events := []Event{
-		GameStarted{
-			Hands:     g.HandsCopy(),
-			TurnOrder: turnOrder,
-			OwnerID:   g.OwnerID,
-		},
-		TurnChanged{
-			ActivePlayerID: turnOrder[g.CurrentIdx],
-			Board:          g.Board,
-		},
+// PlayCardsAt plays the cards at the given hand positions, provided handVersion is the
+// player's current hand version. A handVersion of 0 skips the check.
+func (g *Game) PlayCardsAt(playerID string, indices []int, handVersion int) ([]Event, error) {
+	if current := g.HandVersions[playerID]; handVersion != 0 && handVersion != current {
+		return nil, fmt.Errorf("stale hand version %d, current is %d", handVersion, current)
 	}
-	return events, nil
+	return g.PlayCards(playerID, indices)
 }
 
+// PlayCards plays the cards at the given positions of the player's sorted hand.
 func (g *Game) PlayCards(playerID string, indices []int) ([]Event, error) {
+	return g.playCards(playerID, indices, LogEntry{Kind: CommandPlay, PlayerID: playerID})
+}
+
+// playCards validates and applies a play, recording entry in the log once it is accepted.
+func (g *Game) playCards(playerID string, indices []int, entry LogEntry) ([]Event, error) {
 	if !g.isPlaying {
 		return nil, errors.New("match not in progress")
 	}
@@ -223,24 +429,38 @@ func (g *Game) PlayCards(playerID string, indices []int) ([]Event, error) {
 	}
 
 	cardsToPlay := extractByIndices(hand, indices)
-	if !IsValidSet(cardsToPlay) {
+	if !g.rules.IsValidSet(cardsToPlay) {
 		return nil, errors.New("invalid card combination")
 	}
-	if len(g.Board) > 0 && !CanBeat(g.Board, cardsToPlay) {
+	if len(g.Board) > 0 && !g.rules.CanBeat(g.Board, cardsToPlay) {
 		return nil, errors.New("cannot beat current board")
 	}
+	if !g.includesOpeningCard(cardsToPlay) {
+		return nil, fmt.Errorf("first play must include the opening card %v", *g.openingCard)
+	}
+	g.openingCard = nil
+	entry.Cards = append([]Card(nil), cardsToPlay...)
+	g.record(entry)
+
+	chop, isChop := g.detectChop(playerID, cardsToPlay)
 
 	// Update table
 	g.Board = cardsToPlay
 	g.LastActor = playerID
 	g.RoundSkippers = make(map[string]bool)
 
+	g.CardsPlayed[playerID] += len(cardsToPlay)
+
 	remaining := removeByIndices(hand, indices)
 	SortHand(remaining)
 	g.Hands[playerID] = remaining
+	g.HandVersions[playerID]++
 
 	events := []Event{
-		HandUpdated{PlayerID: playerID, Hand: g.HandOf(playerID)},
+		HandUpdated{PlayerID: playerID, Hand: g.HandOf(playerID), Version: g.HandVersions[playerID]},
+	}
+	if isChop {
+		events = append(events, chop)
 	}
 
 	// Check if player has finished their hand (Win Condition logic)
@@ -257,7 +477,7 @@ func (g *Game) PlayCards(playerID string, indices []int) ([]Event, error) {
 		if len(g.Winners) >= len(g.TurnOrder)-1 {
 			g.isPlaying = false
 			// The overall game winner is the 1st place player
-			events = append(events, GameOver{WinnerID: g.Winners[0]})
+			events = append(events, g.gameOver())
 			return events, nil
 		}
 
@@ -272,6 +492,11 @@ func (g *Game) PlayCards(playerID string, indices []int) ([]Event, error) {
 }
 
 func (g *Game) Pass(playerID string) ([]Event, error) {
+	return g.pass(playerID, LogEntry{Kind: CommandPass, PlayerID: playerID})
+}
+
+// pass validates and applies a pass, recording entry in the log once it is accepted.
+func (g *Game) pass(playerID string, entry LogEntry) ([]Event, error) {
 	if !g.isPlaying {
 		return nil, errors.New("match not in progress")
 	}
@@ -285,10 +510,59 @@ func (g *Game) Pass(playerID string) ([]Event, error) {
 		return nil, errors.New("player has already finished")
 	}
 
+	g.record(entry)
 	g.RoundSkippers[playerID] = true
 	return g.advanceTurn(), nil
 }
 
+// Timeout moves for a player whose turn clock ran out: they pass, or lead their lowest card
+// when the round is theirs to open. That card is the opening card if one is required.
+func (g *Game) Timeout(playerID string) ([]Event, error) {
+	if !g.isPlaying {
+		return nil, errors.New("match not in progress")
+	}
+	if len(g.TurnOrder) == 0 || g.TurnOrder[g.CurrentIdx] != playerID {
+		return nil, errors.New("not your turn")
+	}
+
+	entry := LogEntry{Kind: CommandTimeout, PlayerID: playerID}
+	timedOut := TurnTimedOut{PlayerID: playerID}
+	var events []Event
+	var err error
+	if g.LastActor == "" {
+		timedOut.Cards = g.HandOf(playerID)[:1]
+		events, err = g.playCards(playerID, []int{0}, entry)
+	} else {
+		events, err = g.pass(playerID, entry)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append([]Event{timedOut}, events...), nil
+}
+
+// Abandon records that a player left the game for good. Their hand stays in play, to be
+// played out by someone else on their behalf, and the settlement marks them as abandoned.
+func (g *Game) Abandon(playerID string) error {
+	if !g.isPlaying {
+		return errors.New("match not in progress")
+	}
+	if !g.HasPlayer(playerID) {
+		return errors.New("not in this game")
+	}
+	if g.abandoned[playerID] {
+		return errors.New("already abandoned")
+	}
+	g.record(LogEntry{Kind: CommandLeave, PlayerID: playerID})
+	g.abandoned[playerID] = true
+	return nil
+}
+
+// Abandoned reports whether the player left the game, see Abandon.
+func (g *Game) Abandoned(playerID string) bool {
+	return g.abandoned[playerID]
+}
+
 // advanceTurn moves the turn to the next valid player.
 // It skips players who have finished their hands or have passed the current round.
 // It also handles round endings and resets the board if everyone else skips.
@@ -300,6 +574,16 @@ func (g *Game) advanceTurn() []Event {
 	}
 	original := g.CurrentIdx
 
+	// The last actor went out and everyone still playing passed on their final cards:
+	// the round ends and the next player after them leads.
+	if g.LastActor != "" && g.FinishedPlayers[g.LastActor] && g.allActiveSkipped() {
+		for i, uid := range g.TurnOrder {
+			if uid == g.LastActor {
+				return g.endRound(g.LastActor, (i+1)%count)
+			}
+		}
+	}
+
 	for i := 1; i <= count; i++ {
 		nextIdx := (original + i) % count
 		nextID := g.TurnOrder[nextIdx]
@@ -310,22 +594,7 @@ func (g *Game) advanceTurn() []Event {
 
 		// Round ends if we loop back to the last actor and everyone else skipped
 		if g.LastActor != "" && nextID == g.LastActor && g.allOthersSkipped(nextID) {
-			g.Board = nil
-			g.RoundSkippers = make(map[string]bool)
-			g.LastActor = "" // Clear last actor after round end
-
-			// Find next non-finished player to lead the new round
-			g.CurrentIdx = nextIdx
-			// Ensure the actual player who leads the new round is an active player
-			for g.FinishedPlayers[g.TurnOrder[g.CurrentIdx]] {
-				g.CurrentIdx = (g.CurrentIdx + 1) % count
-			}
-
-			events = append(events,
-				RoundEnded{WinnerID: nextID},
-				TurnChanged{ActivePlayerID: g.TurnOrder[g.CurrentIdx], Board: g.Board},
-			)
-			return events
+			return g.endRound(nextID, nextIdx)
 		}
 
 		if g.RoundSkippers[nextID] {
@@ -342,6 +611,36 @@ func (g *Game) advanceTurn() []Event {
 	return events
 }
 
+// endRound clears the board after every other player passed on winnerID's play.
+// The new round is led from leadIdx, moving on past players who have finished.
+func (g *Game) endRound(winnerID string, leadIdx int) []Event {
+	g.Board = nil
+	g.RoundSkippers = make(map[string]bool)
+	g.LastActor = "" // Clear last actor after round end
+	g.chopChainOpen = false
+
+	// Ensure the actual player who leads the new round is an active player
+	g.CurrentIdx = leadIdx
+	for g.FinishedPlayers[g.TurnOrder[g.CurrentIdx]] {
+		g.CurrentIdx = (g.CurrentIdx + 1) % len(g.TurnOrder)
+	}
+
+	return []Event{
+		RoundEnded{WinnerID: winnerID},
+		TurnChanged{ActivePlayerID: g.TurnOrder[g.CurrentIdx], Board: g.Board},
+	}
+}
+
+// allActiveSkipped reports whether every player who has not finished passed this round.
+func (g *Game) allActiveSkipped() bool {
+	for _, uid := range g.TurnOrder {
+		if !g.FinishedPlayers[uid] && !g.RoundSkippers[uid] {
+			return false
+		}
+	}
+	return true
+}
+
 // allOthersSkipped checks if all players who are not the given playerID and have not finished
 // have marked themselves as skipped in the current round.
 func (g *Game) allOthersSkipped(playerID string) bool {
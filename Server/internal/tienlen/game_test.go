@@ -1,15 +1,14 @@
 package tienlen
 
 import (
-	"math/rand"
+	"reflect"
 	"testing"
 )
 
 func TestGameStartDealsHandsAndSetsTurn(t *testing.T) {
-	rand.Seed(1)
 	g := NewGame()
 	players := []string{"p1", "p2", "p3", "p4"}
-	events, err := g.Start(players, "p1", "")
+	events, err := g.StartWithSeed(1, players, "p1", "")
 	if err != nil {
 		t.Fatalf("Start returned error: %v", err)
 	}
@@ -42,6 +41,28 @@ func TestGameStartDealsHandsAndSetsTurn(t *testing.T) {
 	}
 }
 
+func TestStartWithSeedIsReproducible(t *testing.T) {
+	players := []string{"p1", "p2", "p3", "p4"}
+	deal := func(seed int64) *Game {
+		g := NewGame()
+		if _, err := g.StartWithSeed(seed, players, "p1", ""); err != nil {
+			t.Fatalf("StartWithSeed returned error: %v", err)
+		}
+		return g
+	}
+
+	a, b := deal(42), deal(42)
+	if a.Seed != 42 {
+		t.Fatalf("expected the seed to be recorded, got %d", a.Seed)
+	}
+	if !reflect.DeepEqual(a.TurnOrder, b.TurnOrder) || !reflect.DeepEqual(a.Hands, b.Hands) || a.CurrentIdx != b.CurrentIdx {
+		t.Fatalf("expected identical games from the same seed")
+	}
+	if c := deal(43); reflect.DeepEqual(a.Hands, c.Hands) {
+		t.Fatalf("expected different hands from a different seed")
+	}
+}
+
 func TestPlayPassEndsRound(t *testing.T) {
 	g := NewGame()
 	g.TurnOrder = []string{"p1", "p2"}
@@ -493,3 +514,131 @@ func TestLastActorStartsNewRoundWithAnyCard(t *testing.T) {
 		t.Fatalf("PlayCards after round reset should succeed, got error: %v", err)
 	}
 }
+
+func TestRoundEndsWhenEveryonePassesOnFinishingPlay(t *testing.T) {
+	players := []string{"p1", "p2", "p3"}
+	hands := map[string][]Card{
+		"p1": {{Rank: 10, Suit: 0}},                    // Goes out with a single K
+		"p2": {{Rank: 0, Suit: 0}, {Rank: 1, Suit: 0}}, // Cannot beat it
+		"p3": {{Rank: 2, Suit: 0}, {Rank: 3, Suit: 0}}, // Cannot beat it either
+	}
+	g := setupDeterministicGame(players, "p1", hands)
+
+	if _, err := g.PlayCards("p1", []int{0}); err != nil {
+		t.Fatalf("PlayCards p1 error: %v", err)
+	}
+	if _, err := g.Pass("p2"); err != nil {
+		t.Fatalf("Pass p2 error: %v", err)
+	}
+	events, err := g.Pass("p3")
+	if err != nil {
+		t.Fatalf("Pass p3 error: %v", err)
+	}
+
+	if len(g.Board) != 0 || g.LastActor != "" {
+		t.Fatalf("expected the round to end, board=%v lastActor=%q", g.Board, g.LastActor)
+	}
+	if got := g.TurnOrder[g.CurrentIdx]; got != "p2" {
+		t.Fatalf("expected the player after p1 to lead, got %s", got)
+	}
+	var roundEnded bool
+	for _, ev := range events {
+		if e, ok := ev.(RoundEnded); ok {
+			roundEnded = true
+			if e.WinnerID != "p1" {
+				t.Fatalf("expected p1 to win the round, got %s", e.WinnerID)
+			}
+		}
+	}
+	if !roundEnded {
+		t.Fatalf("expected RoundEnded event")
+	}
+}
+
+func TestPlayCardValuesVerifiesOwnership(t *testing.T) {
+	hands := map[string][]Card{
+		"p1": {{Rank: 0, Suit: 0}, {Rank: 4, Suit: 1}, {Rank: 4, Suit: 2}},
+		"p2": {{Rank: 1, Suit: 0}, {Rank: 6, Suit: 0}},
+	}
+	g := setupDeterministicGame([]string{"p1", "p2"}, "p1", hands)
+
+	if _, err := g.PlayCardValues("p1", []Card{{Rank: 6, Suit: 0}}); err == nil {
+		t.Fatalf("expected playing a card held by another player to fail")
+	}
+	if _, err := g.PlayCardValues("p1", []Card{{Rank: 4, Suit: 1}, {Rank: 4, Suit: 1}}); err == nil {
+		t.Fatalf("expected playing the same card twice to fail")
+	}
+
+	// Order does not matter when playing by value.
+	events, err := g.PlayCardValues("p1", []Card{{Rank: 4, Suit: 2}, {Rank: 4, Suit: 1}})
+	if err != nil {
+		t.Fatalf("PlayCardValues error: %v", err)
+	}
+	if got := g.HandOf("p1"); len(got) != 1 || got[0] != (Card{Rank: 0, Suit: 0}) {
+		t.Fatalf("expected only the 3 left in hand, got %v", got)
+	}
+	update, ok := events[0].(HandUpdated)
+	if !ok || update.Version != g.HandVersion("p1") {
+		t.Fatalf("expected a HandUpdated carrying the new version, got %+v", events[0])
+	}
+}
+
+func TestPlayCardsAtRejectsStaleVersion(t *testing.T) {
+	g := NewGame()
+	if _, err := g.StartWithSeed(1, []string{"p1", "p2"}, "p1", ""); err != nil {
+		t.Fatalf("StartWithSeed error: %v", err)
+	}
+	leader := g.TurnOrder[g.CurrentIdx]
+	if v := g.HandVersion(leader); v != InitialHandVersion {
+		t.Fatalf("expected a dealt hand at version %d, got %d", InitialHandVersion, v)
+	}
+
+	if _, err := g.PlayCardsAt(leader, []int{0}, InitialHandVersion+1); err == nil {
+		t.Fatalf("expected a stale hand version to be rejected")
+	}
+	if _, err := g.PlayCardsAt(leader, []int{0}, InitialHandVersion); err != nil {
+		t.Fatalf("PlayCardsAt with the current version error: %v", err)
+	}
+	if v := g.HandVersion(leader); v != InitialHandVersion+1 {
+		t.Fatalf("expected the version to advance after a play, got %d", v)
+	}
+}
+
+func TestTimeoutLeadsLowestOrPasses(t *testing.T) {
+	g := setupDeterministicGame([]string{"p1", "p2", "p3"}, "p1", map[string][]Card{
+		"p1": {{Rank: 5, Suit: 1}, {Rank: 0, Suit: 2}, {Rank: 5, Suit: 2}},
+		"p2": {{Rank: 3, Suit: 0}, {Rank: 9, Suit: 0}},
+		"p3": {{Rank: 4, Suit: 0}, {Rank: 8, Suit: 0}},
+	})
+
+	if _, err := g.Timeout("p2"); err == nil {
+		t.Fatalf("expected a timeout out of turn to be rejected")
+	}
+
+	// p1 leads the round, so the lowest single is played for them.
+	events, err := g.Timeout("p1")
+	if err != nil {
+		t.Fatalf("Timeout error: %v", err)
+	}
+	timedOut, ok := events[0].(TurnTimedOut)
+	if !ok || timedOut.PlayerID != "p1" || !reflect.DeepEqual(timedOut.Cards, []Card{{Rank: 0, Suit: 2}}) {
+		t.Fatalf("expected p1 to lead the lowest single on timeout, got %+v", events)
+	}
+	if !reflect.DeepEqual(g.Board, []Card{{Rank: 0, Suit: 2}}) || len(g.Hands["p1"]) != 2 {
+		t.Fatalf("unexpected board %v and hand %v", g.Board, g.Hands["p1"])
+	}
+
+	// p2 follows, so the timeout is a pass.
+	events, err = g.Timeout("p2")
+	if err != nil {
+		t.Fatalf("Timeout error: %v", err)
+	}
+	if timedOut := events[0].(TurnTimedOut); timedOut.Cards != nil || !g.RoundSkippers["p2"] {
+		t.Fatalf("expected p2 to pass on timeout, got %+v", events)
+	}
+
+	log := g.Log()
+	if len(log) != 2 || log[0].Kind != CommandTimeout || log[1].Kind != CommandTimeout {
+		t.Fatalf("expected both timeouts in the log, got %+v", log)
+	}
+}
@@ -0,0 +1,130 @@
+package tienlen
+
+import (
+	"errors"
+	"sort"
+)
+
+// Hint is a suggested play, with the indices of its cards in the hand it was computed from.
+type Hint struct {
+	Cards   []Card
+	Indices []int
+}
+
+// Hints suggests plays for the player against the current board, best first, capped at limit
+// (0 means no cap). canPass reports whether passing is also an option.
+func (g *Game) Hints(playerID string, limit int) (hints []Hint, canPass bool, err error) {
+	if !g.isPlaying {
+		return nil, false, errors.New("match not in progress")
+	}
+	hand, ok := g.Hands[playerID]
+	if !ok {
+		return nil, false, errors.New("player has no hand")
+	}
+	hints = RankHints(hand, g.Board, g.rules)
+	if opening, ok := g.OpeningCard(); ok {
+		hints = HintsWith(hints, opening)
+	}
+	if limit > 0 && len(hints) > limit {
+		hints = hints[:limit]
+	}
+	return hints, g.LastActor != "", nil
+}
+
+// RankHints orders the legal moves for hand by how sensible they are to play now:
+// cheap plays first, keeping 2s and bombs in reserve and avoiding breaking up bombs.
+// When leading, longer combinations are preferred since they shed more cards.
+func RankHints(hand []Card, board []Card, rules RuleSet) []Hint {
+	moves := LegalMoves(hand, board, rules)
+	bombCards := bombMembers(hand, rules)
+	leading := len(board) == 0
+
+	type scored struct {
+		hint Hint
+		cost int
+	}
+	ranked := make([]scored, 0, len(moves))
+	for _, move := range moves {
+		combo := Classify(move)
+		cost := int(cardPower(combo.Top))
+		if combo.IsTwos() {
+			cost += 100 // Keep 2s for when they matter
+		}
+		if combo.IsBomb() {
+			cost += 200 // Save bombs for chopping
+		} else if breaksBomb(move, bombCards) {
+			cost += 50
+		}
+		if leading {
+			cost -= 8 * len(move)
+		}
+		ranked = append(ranked, scored{
+			hint: Hint{Cards: move, Indices: IndicesOf(hand, move)},
+			cost: cost,
+		})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].cost < ranked[j].cost })
+	hints := make([]Hint, len(ranked))
+	for i, r := range ranked {
+		hints[i] = r.hint
+	}
+	return hints
+}
+
+// HintsWith keeps the hints that include card c, e.g. the opening card of a small table.
+func HintsWith(hints []Hint, c Card) []Hint {
+	out := make([]Hint, 0, len(hints))
+	for _, h := range hints {
+		if len(IndicesOf(h.Cards, []Card{c})) == 1 {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+// bombMembers marks the cards of the hand that belong to a playable bomb: every card of a
+// rank held four times or more, and every card of a run of three or more consecutive pairs.
+// It counts ranks rather than enumerating the hand's moves again.
+func bombMembers(hand []Card, rules RuleSet) map[Card]bool {
+	byRank := make(map[int32][]Card)
+	for _, c := range hand {
+		byRank[c.Rank] = append(byRank[c.Rank], c)
+	}
+	members := make(map[Card]bool)
+	mark := func(ranks ...int32) {
+		for _, r := range ranks {
+			for _, c := range byRank[r] {
+				members[c] = true
+			}
+		}
+	}
+	for r, cards := range byRank {
+		if len(cards) >= 4 && rules.IsValidSet(cards[:4]) {
+			mark(r)
+		}
+	}
+	// 2s cannot be part of consecutive pairs.
+	for start := int32(0); start < rankTwo; start++ {
+		var ranks []int32
+		var pine []Card
+		for r := start; r < rankTwo && len(byRank[r]) >= 2; r++ {
+			ranks = append(ranks, r)
+			pine = append(pine, byRank[r][:2]...)
+		}
+		if len(ranks) >= 3 && rules.IsValidSet(pine) {
+			mark(ranks...)
+			start = ranks[len(ranks)-1]
+		}
+	}
+	return members
+}
+
+func breaksBomb(move []Card, bombCards map[Card]bool) bool {
+	for _, c := range move {
+		if bombCards[c] {
+			return true
+		}
+	}
+	return false
+}
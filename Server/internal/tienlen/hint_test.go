@@ -0,0 +1,97 @@
+package tienlen
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestHintsPreferCheapPlays(t *testing.T) {
+	quad := []Card{{Rank: 8, Suit: 0}, {Rank: 8, Suit: 1}, {Rank: 8, Suit: 2}, {Rank: 8, Suit: 3}}
+	g := setupDeterministicGame([]string{"p1", "p2"}, "p1", map[string][]Card{
+		"p1": append([]Card{{Rank: 4, Suit: 1}, {Rank: 12, Suit: 3}}, quad...),
+		"p2": {{Rank: 0, Suit: 0}},
+	})
+	g.Board = []Card{{Rank: 2, Suit: 0}}
+	g.LastActor = "p2"
+
+	hints, canPass, err := g.Hints("p1", 0)
+	if err != nil {
+		t.Fatalf("Hints error: %v", err)
+	}
+	if !canPass {
+		t.Fatalf("expected passing to be allowed when following a play")
+	}
+	if len(hints) == 0 {
+		t.Fatalf("expected hints")
+	}
+
+	best := hints[0]
+	if len(best.Cards) != 1 || best.Cards[0] != (Card{Rank: 4, Suit: 1}) {
+		t.Fatalf("expected the plain 7 to be suggested first, got %v", best.Cards)
+	}
+	hand := g.HandOf("p1")
+	for i, idx := range best.Indices {
+		if hand[idx] != best.Cards[i] {
+			t.Fatalf("hint index %d points to %v, want %v", idx, hand[idx], best.Cards[i])
+		}
+	}
+	for _, h := range hints[:2] {
+		if Classify(h.Cards).IsTwos() {
+			t.Fatalf("expected the 2 to be held back behind cheaper plays, got %v", hints)
+		}
+	}
+}
+
+func TestHintsLimitAndErrors(t *testing.T) {
+	g := setupDeterministicGame([]string{"p1"}, "p1", map[string][]Card{
+		"p1": {{Rank: 0, Suit: 0}, {Rank: 1, Suit: 0}, {Rank: 2, Suit: 0}, {Rank: 3, Suit: 0}},
+	})
+
+	hints, canPass, err := g.Hints("p1", 2)
+	if err != nil {
+		t.Fatalf("Hints error: %v", err)
+	}
+	if len(hints) != 2 {
+		t.Fatalf("expected hints capped at 2, got %d", len(hints))
+	}
+	if canPass {
+		t.Fatalf("the leader of a new round cannot pass")
+	}
+	if len(hints[0].Cards) != 4 {
+		t.Fatalf("expected the longest straight to be suggested when leading, got %v", hints[0].Cards)
+	}
+
+	if _, _, err := g.Hints("ghost", 0); err == nil {
+		t.Fatalf("expected error for a player without a hand")
+	}
+	g.isPlaying = false
+	if _, _, err := g.Hints("p1", 0); err == nil {
+		t.Fatalf("expected error when no game is in progress")
+	}
+}
+
+func TestBombMembersMatchesLegalBombs(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, rules := range []RuleSet{SouthernRules{}, NorthernRules{}} {
+		for i := 0; i < 200; i++ {
+			deck := NewDecks(1 + i%2)
+			rng.Shuffle(len(deck), func(a, b int) { deck[a], deck[b] = deck[b], deck[a] })
+			hand := deck[:13+rng.Intn(14)]
+			SortHand(hand)
+
+			want := make(map[Card]bool)
+			for _, move := range LegalMoves(hand, nil, rules) {
+				if Classify(move).IsBomb() {
+					for _, c := range move {
+						want[c] = true
+					}
+				}
+			}
+			got := bombMembers(hand, rules)
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s hand %v: bomb members %v, want %v", rules.Variant(), hand, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package tienlen
+
+// InstantWinPattern names a dealt hand that wins on the spot (toi trang).
+type InstantWinPattern string
+
+const (
+	InstantWinDragon               InstantWinPattern = "dragon"                 // sanh rong: one of every rank from 3 to A
+	InstantWinFourTwos             InstantWinPattern = "four_twos"              // tu quy heo: all four 2s
+	InstantWinFiveConsecutivePairs InstantWinPattern = "five_consecutive_pairs" // 5 doi thong
+	InstantWinSixPairs             InstantWinPattern = "six_pairs"              // 6 doi: any six pairs
+)
+
+// instantWinChecks maps every pattern to its detector.
+var instantWinChecks = map[InstantWinPattern]func(hand []Card) bool{
+	InstantWinDragon:               hasDragon,
+	InstantWinFourTwos:             hasFourTwos,
+	InstantWinFiveConsecutivePairs: hasFiveConsecutivePairs,
+	InstantWinSixPairs:             hasSixPairs,
+}
+
+// singleDeckPatterns only win when one deck is dealt: from two decks four 2s are no longer
+// all of them, and six pairs in a hand are common.
+var singleDeckPatterns = map[InstantWinPattern]bool{
+	InstantWinFourTwos: true,
+	InstantWinSixPairs: true,
+}
+
+// matchInstantWin returns the first pattern, in priority order, that the hand satisfies.
+func matchInstantWin(hand []Card, decks int, patterns []InstantWinPattern) (InstantWinPattern, bool) {
+	for _, p := range patterns {
+		if decks > 1 && singleDeckPatterns[p] {
+			continue
+		}
+		if check, ok := instantWinChecks[p]; ok && check(hand) {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// rankCounts tallies how many cards of each rank the hand holds.
+func rankCounts(hand []Card) map[int32]int {
+	counts := make(map[int32]int, len(hand))
+	for _, c := range hand {
+		counts[c.Rank]++
+	}
+	return counts
+}
+
+func hasDragon(hand []Card) bool {
+	counts := rankCounts(hand)
+	for r := int32(0); r < rankTwo; r++ {
+		if counts[r] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func hasFourTwos(hand []Card) bool {
+	return rankCounts(hand)[rankTwo] >= 4
+}
+
+func hasFiveConsecutivePairs(hand []Card) bool {
+	counts := rankCounts(hand)
+	run := 0
+	for r := int32(0); r < rankTwo; r++ { // 2s cannot be part of consecutive pairs
+		if counts[r] >= 2 {
+			run++
+			if run >= 5 {
+				return true
+			}
+		} else {
+			run = 0
+		}
+	}
+	return false
+}
+
+// hasSixPairs counts disjoint pairs, so a quad contributes two.
+func hasSixPairs(hand []Card) bool {
+	pairs := 0
+	for _, n := range rankCounts(hand) {
+		pairs += n / 2
+	}
+	return pairs >= 6
+}
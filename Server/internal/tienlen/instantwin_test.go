@@ -0,0 +1,80 @@
+package tienlen
+
+import "testing"
+
+func TestInstantWinPatterns(t *testing.T) {
+	dragon := []Card{
+		{Rank: 0, Suit: 0}, {Rank: 1, Suit: 1}, {Rank: 2, Suit: 2}, {Rank: 3, Suit: 3}, {Rank: 4, Suit: 0}, {Rank: 5, Suit: 1},
+		{Rank: 6, Suit: 2}, {Rank: 7, Suit: 3}, {Rank: 8, Suit: 0}, {Rank: 9, Suit: 1}, {Rank: 10, Suit: 2}, {Rank: 11, Suit: 3}, {Rank: 11, Suit: 0}}
+	fourTwos := []Card{
+		{Rank: 12, Suit: 0}, {Rank: 12, Suit: 1}, {Rank: 12, Suit: 2}, {Rank: 12, Suit: 3}, {Rank: 0, Suit: 0}, {Rank: 2, Suit: 1},
+		{Rank: 4, Suit: 2}, {Rank: 6, Suit: 3}, {Rank: 8, Suit: 0}, {Rank: 9, Suit: 1}, {Rank: 10, Suit: 2}, {Rank: 11, Suit: 3}, {Rank: 1, Suit: 0}}
+	fivePairs := []Card{
+		{Rank: 3, Suit: 0}, {Rank: 3, Suit: 1}, {Rank: 4, Suit: 0}, {Rank: 4, Suit: 1}, {Rank: 5, Suit: 0}, {Rank: 5, Suit: 1},
+		{Rank: 6, Suit: 0}, {Rank: 6, Suit: 1}, {Rank: 7, Suit: 0}, {Rank: 7, Suit: 1}, {Rank: 0, Suit: 2}, {Rank: 10, Suit: 3}, {Rank: 12, Suit: 0}}
+	sixPairs := []Card{
+		{Rank: 0, Suit: 0}, {Rank: 0, Suit: 1}, {Rank: 2, Suit: 0}, {Rank: 2, Suit: 1}, {Rank: 4, Suit: 0}, {Rank: 4, Suit: 1},
+		{Rank: 6, Suit: 0}, {Rank: 6, Suit: 1}, {Rank: 8, Suit: 0}, {Rank: 8, Suit: 1}, {Rank: 10, Suit: 0}, {Rank: 10, Suit: 1}, {Rank: 12, Suit: 3}}
+	ordinary := []Card{
+		{Rank: 0, Suit: 0}, {Rank: 0, Suit: 1}, {Rank: 1, Suit: 0}, {Rank: 3, Suit: 1}, {Rank: 4, Suit: 0}, {Rank: 4, Suit: 1},
+		{Rank: 6, Suit: 0}, {Rank: 7, Suit: 1}, {Rank: 8, Suit: 0}, {Rank: 9, Suit: 1}, {Rank: 10, Suit: 0}, {Rank: 11, Suit: 1}, {Rank: 12, Suit: 3}}
+
+	tests := []struct {
+		name         string
+		hand         []Card
+		southern     InstantWinPattern
+		northernWins bool
+	}{
+		{"dragon", dragon, InstantWinDragon, true},
+		{"four twos", fourTwos, InstantWinFourTwos, true},
+		{"five consecutive pairs", fivePairs, InstantWinFiveConsecutivePairs, false},
+		{"six pairs", sixPairs, InstantWinSixPairs, false},
+		{"ordinary", ordinary, "", false},
+	}
+
+	for _, tt := range tests {
+		pattern, ok := SouthernRules{}.InstantWin(tt.hand, 1)
+		if ok != (tt.southern != "") || pattern != tt.southern {
+			t.Errorf("%s: southern InstantWin() = %q, %v; want %q", tt.name, pattern, ok, tt.southern)
+		}
+		if _, ok := (NorthernRules{}).InstantWin(tt.hand, 1); ok != tt.northernWins {
+			t.Errorf("%s: northern InstantWin() ok = %v, want %v", tt.name, ok, tt.northernWins)
+		}
+	}
+
+	// From two decks, four 2s and six pairs no longer win on the spot.
+	for _, hand := range [][]Card{fourTwos, sixPairs} {
+		if pattern, ok := (SouthernRules{}).InstantWin(hand, 2); ok {
+			t.Errorf("expected no instant win from two decks, got %q", pattern)
+		}
+	}
+	if pattern, _ := (SouthernRules{}).InstantWin(dragon, 2); pattern != InstantWinDragon {
+		t.Errorf("expected the dragon to win from two decks, got %q", pattern)
+	}
+}
+
+func TestDetectInstantWinPrefersStartingPlayer(t *testing.T) {
+	fourTwos := []Card{
+		{Rank: 12, Suit: 0}, {Rank: 12, Suit: 1}, {Rank: 12, Suit: 2}, {Rank: 12, Suit: 3}, {Rank: 0, Suit: 0}}
+	sixPairs := []Card{
+		{Rank: 0, Suit: 2}, {Rank: 0, Suit: 3}, {Rank: 2, Suit: 0}, {Rank: 2, Suit: 1}, {Rank: 4, Suit: 0}, {Rank: 4, Suit: 1},
+		{Rank: 6, Suit: 0}, {Rank: 6, Suit: 1}, {Rank: 8, Suit: 0}, {Rank: 8, Suit: 1}, {Rank: 10, Suit: 0}, {Rank: 10, Suit: 1}}
+	g := setupDeterministicGame([]string{"p1", "p2", "p3"}, "p1", map[string][]Card{
+		"p1": fourTwos,
+		"p2": {{Rank: 1, Suit: 0}},
+		"p3": sixPairs,
+	})
+	g.CurrentIdx = 1 // p2 leads, so p3 is checked before p1
+	g.handSize = DefaultHandSize
+
+	win, ok := g.detectInstantWin()
+	if !ok {
+		t.Fatalf("expected an instant win to be detected")
+	}
+	if win.PlayerID != "p3" || win.Pattern != InstantWinSixPairs {
+		t.Fatalf("expected p3 to win with six pairs, got %+v", win)
+	}
+	if len(win.Hand) != len(sixPairs) {
+		t.Fatalf("expected revealed hand of %d cards, got %d", len(sixPairs), len(win.Hand))
+	}
+}
@@ -0,0 +1,92 @@
+package tienlen
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CommandKind names a command accepted by the game.
+type CommandKind string
+
+const (
+	CommandStart   CommandKind = "start"
+	CommandPlay    CommandKind = "play"
+	CommandPass    CommandKind = "pass"
+	CommandTimeout CommandKind = "timeout" // The server moved for a player whose turn clock ran out
+	CommandLeave   CommandKind = "leave"   // The player abandoned the game; see Game.Abandon
+)
+
+// LogEntry records one accepted command. The log of a game is append-only and numbered
+// from 1, and replaying it with Replay rebuilds the game exactly.
+type LogEntry struct {
+	Seq      int         `json:"seq"`
+	Kind     CommandKind `json:"kind"`
+	PlayerID string      `json:"player_id,omitempty"`
+	Cards    []Card      `json:"cards,omitempty"` // Cards played, by value
+
+	// Start parameters.
+	Variant      Variant         `json:"variant,omitempty"`
+	Seed         int64           `json:"seed,omitempty"`
+	Players      []string        `json:"players,omitempty"`
+	OwnerID      string          `json:"owner_id,omitempty"`
+	LastWinnerID string          `json:"last_winner_id,omitempty"`
+	Commitment   *DealCommitment `json:"commitment,omitempty"`
+	Deal         DealConfig      `json:"deal"`
+}
+
+// Log returns a copy of the commands the game has accepted so far.
+func (g *Game) Log() []LogEntry {
+	return append([]LogEntry(nil), g.log...)
+}
+
+func (g *Game) record(entry LogEntry) {
+	entry.Seq = len(g.log) + 1
+	g.log = append(g.log, entry)
+}
+
+// Replay rebuilds a game by applying every command of log in order to a fresh game.
+// It fails if the log is malformed or a command is rejected, which means the log was
+// not produced by this engine version.
+func Replay(log []LogEntry) (*Game, error) {
+	if len(log) == 0 || log[0].Kind != CommandStart {
+		return nil, errors.New("log must begin with a start command")
+	}
+	rules, err := RuleSetFor(log[0].Variant)
+	if err != nil {
+		return nil, err
+	}
+
+	g := NewGameWithRules(rules)
+	for i, entry := range log {
+		if entry.Seq != i+1 {
+			return nil, fmt.Errorf("entry %d has sequence number %d", i+1, entry.Seq)
+		}
+		var err error
+		switch entry.Kind {
+		case CommandStart:
+			if i > 0 {
+				return nil, fmt.Errorf("entry %d: start after the game began", entry.Seq)
+			}
+			g.Deal = entry.Deal
+			if entry.Commitment != nil {
+				_, err = g.StartCommitted(*entry.Commitment, entry.Players, entry.OwnerID, entry.LastWinnerID)
+			} else {
+				_, err = g.StartWithSeed(entry.Seed, entry.Players, entry.OwnerID, entry.LastWinnerID)
+			}
+		case CommandPlay:
+			_, err = g.PlayCardValues(entry.PlayerID, entry.Cards)
+		case CommandPass:
+			_, err = g.Pass(entry.PlayerID)
+		case CommandTimeout:
+			_, err = g.Timeout(entry.PlayerID)
+		case CommandLeave:
+			err = g.Abandon(entry.PlayerID)
+		default:
+			err = fmt.Errorf("unknown command %q", entry.Kind)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("entry %d (%s): %w", entry.Seq, entry.Kind, err)
+		}
+	}
+	return g, nil
+}
@@ -0,0 +1,97 @@
+package tienlen
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReplayRebuildsGame(t *testing.T) {
+	g := NewGame()
+	if _, err := g.StartWithSeed(1, []string{"p1", "p2", "p3"}, "p1", ""); err != nil {
+		t.Fatalf("StartWithSeed error: %v", err)
+	}
+
+	// Play the game out, mostly passing when allowed and otherwise playing the first hint,
+	// with the occasional timeout. p3 abandons the game early and plays on as before.
+	for moves := 0; g.IsPlaying(); moves++ {
+		if moves > 1000 {
+			t.Fatalf("game did not finish")
+		}
+		if moves == 5 {
+			if err := g.Abandon("p3"); err != nil {
+				t.Fatalf("Abandon error: %v", err)
+			}
+			if err := g.Abandon("p3"); err == nil {
+				t.Fatalf("expected a second Abandon to fail")
+			}
+		}
+		active := g.TurnOrder[g.CurrentIdx]
+		hints, canPass, err := g.Hints(active, 1)
+		if err != nil {
+			t.Fatalf("Hints error: %v", err)
+		}
+		switch {
+		case moves%7 == 6:
+			_, err = g.Timeout(active)
+		case canPass && (len(hints) == 0 || moves%3 != 0):
+			_, err = g.Pass(active)
+		default:
+			_, err = g.PlayCardValues(active, hints[0].Cards)
+		}
+		if err != nil {
+			t.Fatalf("move %d by %s: %v", moves, active, err)
+		}
+	}
+
+	log := g.Log()
+	if log[0].Kind != CommandStart || log[0].Seed != 1 {
+		t.Fatalf("expected the log to begin with the start, got %+v", log[0])
+	}
+	for i, entry := range log {
+		if entry.Seq != i+1 {
+			t.Fatalf("entry %d has sequence number %d", i, entry.Seq)
+		}
+	}
+
+	if r, _ := g.Settle().Result("p3"); !r.Abandoned {
+		t.Fatalf("expected p3 to be settled as abandoned, got %+v", r)
+	}
+
+	replayed, err := Replay(log)
+	if err != nil {
+		t.Fatalf("Replay error: %v", err)
+	}
+	if want, got := g.State(), replayed.State(); !reflect.DeepEqual(want, got) {
+		t.Fatalf("replayed game differs:\nwant %+v\ngot  %+v", want, got)
+	}
+}
+
+func TestReplayRejectsBadLog(t *testing.T) {
+	g := NewGame()
+	if _, err := g.StartWithSeed(1, []string{"p1", "p2"}, "p1", ""); err != nil {
+		t.Fatalf("StartWithSeed error: %v", err)
+	}
+	leader := g.TurnOrder[g.CurrentIdx]
+	if _, err := g.PlayCardValues(leader, g.Hands[leader][:1]); err != nil {
+		t.Fatalf("PlayCardValues error: %v", err)
+	}
+	log := g.Log()
+
+	tests := []struct {
+		name string
+		log  []LogEntry
+	}{
+		{"empty", nil},
+		{"no start", log[1:]},
+		{"gap in sequence", []LogEntry{log[0], {Seq: 3, Kind: CommandPass, PlayerID: leader}}},
+		{"rejected command", []LogEntry{log[0], {Seq: 2, Kind: CommandPass, PlayerID: leader}}},
+		{"unknown command", []LogEntry{log[0], {Seq: 2, Kind: "shuffle"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Replay(tt.log); err == nil {
+				t.Fatalf("expected Replay to fail")
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package tienlen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxRunChoices caps the mixes of suits enumerated for a single straight or run of
+// consecutive pairs. Hands dealt from two decks or dealt in full can hold enough duplicate
+// ranks for the mixes to number in the tens of thousands.
+const maxRunChoices = 256
+
+// LegalMoves enumerates every combination from hand that may legally be played on board
+// under the given rules. An empty board means the player leads and any valid combination
+// is allowed. A run with more than maxRunChoices mixes of suits is cut down to one mix per
+// top card and suit; see sampleStraights. Moves are deduplicated and ordered from weakest
+// to strongest: ordinary plays by top card, then bombs by chopping power.
+func LegalMoves(hand []Card, board []Card, rules RuleSet) [][]Card {
+	byRank := make(map[int32][]Card)
+	for _, c := range hand {
+		byRank[c.Rank] = append(byRank[c.Rank], c)
+	}
+	for _, cards := range byRank {
+		SortHand(cards)
+	}
+
+	var candidates [][]Card
+
+	// Singles, pairs, triples and quads.
+	for _, cards := range byRank {
+		for k := 1; k <= len(cards); k++ {
+			candidates = append(candidates, combinations(cards, k)...)
+		}
+	}
+
+	// Straights and consecutive pairs: every run of >= 3 ranks below the 2.
+	for start := int32(0); start < rankTwo; start++ {
+		var ranks []int32
+		singleParts := [][][]Card{}
+		pairParts := [][][]Card{}
+		for r := start; r < rankTwo && len(byRank[r]) > 0; r++ {
+			ranks = append(ranks, r)
+			singleParts = append(singleParts, combinations(byRank[r], 1))
+			if len(ranks) >= 3 {
+				if productSize(singleParts) <= maxRunChoices {
+					candidates = append(candidates, product(singleParts)...)
+				} else {
+					candidates = append(candidates, sampleStraights(byRank, ranks)...)
+				}
+			}
+			if len(pairParts) == len(ranks)-1 && len(byRank[r]) >= 2 {
+				pairParts = append(pairParts, combinations(byRank[r], 2))
+				if len(pairParts) >= 3 {
+					if productSize(pairParts) <= maxRunChoices {
+						candidates = append(candidates, product(pairParts)...)
+					} else {
+						candidates = append(candidates, product(topChoicesOnly(pairParts))...)
+					}
+				}
+			}
+		}
+	}
+
+	seen := make(map[string]bool, len(candidates))
+	moves := make([][]Card, 0, len(candidates))
+	for _, move := range candidates {
+		if !rules.IsValidSet(move) {
+			continue
+		}
+		if len(board) > 0 && !rules.CanBeat(board, move) {
+			continue
+		}
+		key := movesKey(move)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		moves = append(moves, move)
+	}
+
+	sort.SliceStable(moves, func(i, j int) bool {
+		a, b := Classify(moves[i]), Classify(moves[j])
+		if weakerMove(a, b) != weakerMove(b, a) {
+			return weakerMove(a, b)
+		}
+		return movesKey(moves[i]) < movesKey(moves[j])
+	})
+	return moves
+}
+
+// weakerMove orders ordinary plays before bombs, bombs by chopping power,
+// and otherwise by top card, then by size.
+func weakerMove(a, b Combo) bool {
+	if bombRank(a) != bombRank(b) {
+		return bombRank(a) < bombRank(b)
+	}
+	if cardPower(a.Top) != cardPower(b.Top) {
+		return cardPower(a.Top) < cardPower(b.Top)
+	}
+	if a.Length != b.Length {
+		return a.Length < b.Length
+	}
+	return a.Kind < b.Kind
+}
+
+// bombRank is 0 for ordinary plays and grows with chopping power:
+// 3-pine, quad, longer pines, then five to eight of a kind.
+func bombRank(c Combo) int {
+	switch {
+	case c.Kind == ComboOfAKind:
+		return 10 + c.Length
+	case c.Pairs() == 3:
+		return 1
+	case c.Kind == ComboQuad:
+		return 2
+	case c.Pairs() > 3:
+		return c.Pairs() - 1
+	}
+	return 0
+}
+
+// sampleStraights stands in for every straight over ranks when there are too many to list.
+// Runs compare by their top card alone, so each top card is completed once with the lowest
+// card of every rank below it and once per suit, for rules that want straights of one suit.
+func sampleStraights(byRank map[int32][]Card, ranks []int32) [][]Card {
+	lower, top := ranks[:len(ranks)-1], ranks[len(ranks)-1]
+	var out [][]Card
+	for _, t := range byRank[top] {
+		for suit := int32(-1); suit < 4; suit++ {
+			straight := make([]Card, 0, len(ranks))
+			for _, r := range lower {
+				straight = append(straight, cardOfSuit(byRank[r], suit))
+			}
+			out = append(out, append(straight, t))
+		}
+	}
+	return out
+}
+
+// cardOfSuit picks the card of the given suit, or the lowest card if there is none.
+func cardOfSuit(cards []Card, suit int32) Card {
+	for _, c := range cards {
+		if c.Suit == suit {
+			return c
+		}
+	}
+	return cards[0]
+}
+
+// topChoicesOnly keeps a single choice for every part but the last, standing in for every
+// run of consecutive pairs when there are too many to list: like straights, they compare
+// by their top card.
+func topChoicesOnly(parts [][][]Card) [][][]Card {
+	out := make([][][]Card, len(parts))
+	for i, choices := range parts {
+		if i < len(parts)-1 {
+			choices = choices[:1]
+		}
+		out[i] = choices
+	}
+	return out
+}
+
+// combinations returns every k-card subset of cards, preserving order.
+func combinations(cards []Card, k int) [][]Card {
+	var out [][]Card
+	var pick func(start int, acc []Card)
+	pick = func(start int, acc []Card) {
+		if len(acc) == k {
+			out = append(out, append([]Card(nil), acc...))
+			return
+		}
+		for i := start; i < len(cards); i++ {
+			pick(i+1, append(acc, cards[i]))
+		}
+	}
+	pick(0, make([]Card, 0, k))
+	return out
+}
+
+// productSize counts the results product would return for parts.
+func productSize(parts [][][]Card) int {
+	n := 1
+	for _, choices := range parts {
+		n *= len(choices)
+		if n > maxRunChoices {
+			return n
+		}
+	}
+	return n
+}
+
+// product concatenates one choice from each part, for every possible choice.
+func product(parts [][][]Card) [][]Card {
+	out := [][]Card{nil}
+	for _, choices := range parts {
+		next := make([][]Card, 0, len(out)*len(choices))
+		for _, prefix := range out {
+			for _, choice := range choices {
+				combo := make([]Card, 0, len(prefix)+len(choice))
+				combo = append(combo, prefix...)
+				combo = append(combo, choice...)
+				next = append(next, combo)
+			}
+		}
+		out = next
+	}
+	return out
+}
+
+// movesKey identifies a move regardless of card order, so identical moves are reported once.
+func movesKey(cards []Card) string {
+	sorted := append([]Card(nil), cards...)
+	SortHand(sorted)
+	parts := make([]string, len(sorted))
+	for i, c := range sorted {
+		parts[i] = fmt.Sprintf("%d:%d", c.Rank, c.Suit)
+	}
+	return strings.Join(parts, ",")
+}
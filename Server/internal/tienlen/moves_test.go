@@ -0,0 +1,113 @@
+package tienlen
+
+import "testing"
+
+func TestLegalMovesLeading(t *testing.T) {
+	hand := []Card{
+		{Rank: 0, Suit: 0}, {Rank: 0, Suit: 1},
+		{Rank: 1, Suit: 0}, {Rank: 1, Suit: 1},
+		{Rank: 2, Suit: 0}, {Rank: 2, Suit: 1},
+		{Rank: 12, Suit: 3},
+	}
+	moves := LegalMoves(hand, nil, SouthernRules{})
+
+	counts := map[ComboKind]int{}
+	for _, m := range moves {
+		combo := Classify(m)
+		if !combo.IsValid() {
+			t.Fatalf("LegalMoves returned invalid move %v", m)
+		}
+		counts[combo.Kind]++
+	}
+
+	// 7 singles, 3 pairs, 8 straights (2*2*2 suit choices of 3-4-5), 1 run of 3 consecutive pairs.
+	want := map[ComboKind]int{ComboSingle: 7, ComboPair: 3, ComboStraight: 8, ComboConsecutivePairs: 1}
+	for kind, n := range want {
+		if counts[kind] != n {
+			t.Errorf("expected %d %s moves, got %d", n, kind, counts[kind])
+		}
+	}
+
+	// Weakest first, bombs last.
+	if first := Classify(moves[0]); first.Kind != ComboSingle || first.Top != (Card{Rank: 0, Suit: 0}) {
+		t.Errorf("expected 3 of spades to be the weakest move, got %v", moves[0])
+	}
+	if last := Classify(moves[len(moves)-1]); last.Kind != ComboConsecutivePairs {
+		t.Errorf("expected the 3-pine to be the strongest move, got %v", moves[len(moves)-1])
+	}
+}
+
+func TestLegalMovesFollowingBoard(t *testing.T) {
+	hand := []Card{
+		{Rank: 5, Suit: 0}, {Rank: 5, Suit: 2},
+		{Rank: 9, Suit: 1},
+		{Rank: 7, Suit: 0}, {Rank: 7, Suit: 1}, {Rank: 7, Suit: 2}, {Rank: 7, Suit: 3},
+	}
+
+	moves := LegalMoves(hand, []Card{{Rank: 12, Suit: 0}}, SouthernRules{})
+	if len(moves) != 1 || Classify(moves[0]).Kind != ComboQuad {
+		t.Fatalf("expected only the quad to chop a single 2, got %v", moves)
+	}
+
+	moves = LegalMoves(hand, []Card{{Rank: 6, Suit: 3}, {Rank: 6, Suit: 2}}, SouthernRules{})
+	for _, m := range moves {
+		if Classify(m).Kind != ComboPair {
+			t.Fatalf("expected only pairs to follow a pair, got %v", m)
+		}
+	}
+	if len(moves) != 6 {
+		t.Fatalf("expected the 6 pairs of 10s to beat a pair of 9s, got %d moves", len(moves))
+	}
+}
+
+func TestLegalMovesRespectsRuleSetAndDeduplicates(t *testing.T) {
+	hand := []Card{{Rank: 0, Suit: 0}, {Rank: 1, Suit: 1}, {Rank: 2, Suit: 0}, {Rank: 2, Suit: 0}}
+
+	for _, m := range LegalMoves(hand, nil, NorthernRules{}) {
+		if Classify(m).Kind == ComboStraight {
+			t.Fatalf("northern rules should reject the mixed-suit straight %v", m)
+		}
+	}
+
+	singles := 0
+	for _, m := range LegalMoves(hand, nil, SouthernRules{}) {
+		if len(m) == 1 && m[0] == (Card{Rank: 2, Suit: 0}) {
+			singles++
+		}
+	}
+	if singles != 1 {
+		t.Fatalf("expected identical cards to yield a single move, got %d", singles)
+	}
+}
+
+func TestLegalMovesCapsRunsWithDuplicateRanks(t *testing.T) {
+	// Three copies each of 3 to 10, as dealt from two decks: every mix of suits would make
+	// tens of thousands of straights, so the longest runs are cut down to maxRunChoices.
+	var hand []Card
+	for r := int32(0); r < 8; r++ {
+		for suit := int32(0); suit < 3; suit++ {
+			hand = append(hand, Card{Rank: r, Suit: suit})
+		}
+	}
+	hand = append(hand, Card{Rank: 12, Suit: 0}, Card{Rank: 12, Suit: 1})
+
+	moves := LegalMoves(hand, nil, SouthernRules{})
+	if len(moves) > 5000 {
+		t.Fatalf("expected the cap to keep the moves in check, got %d", len(moves))
+	}
+	var longest, oneSuit bool
+	for _, m := range moves {
+		combo := Classify(m)
+		if combo.Kind == ComboStraight && combo.Length == 8 && combo.Top == (Card{Rank: 7, Suit: 2}) {
+			longest = true
+		}
+	}
+	for _, m := range LegalMoves(hand, nil, NorthernRules{}) {
+		if combo := Classify(m); combo.Kind == ComboStraight && combo.Length == 8 {
+			oneSuit = true
+		}
+	}
+	if !longest || !oneSuit {
+		t.Fatalf("expected the full straight under both rule sets, got southern %v, northern %v", longest, oneSuit)
+	}
+}
@@ -138,4 +138,32 @@ func TestCanBeat(t *testing.T) {
 			t.Errorf("%s: CanBeat() = %v, want %v", tt.name, got, tt.want)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestCanBeatRequiresSameCombination(t *testing.T) {
+	triple := []Card{{Rank: 4, Suit: 0}, {Rank: 4, Suit: 1}, {Rank: 4, Suit: 2}}
+	straight3 := []Card{{Rank: 5, Suit: 0}, {Rank: 6, Suit: 1}, {Rank: 7, Suit: 2}}
+	straight6 := []Card{{Rank: 5, Suit: 0}, {Rank: 6, Suit: 1}, {Rank: 7, Suit: 2}, {Rank: 8, Suit: 3}, {Rank: 9, Suit: 0}, {Rank: 10, Suit: 1}}
+	pine3 := []Card{{Rank: 0, Suit: 0}, {Rank: 0, Suit: 1}, {Rank: 1, Suit: 0}, {Rank: 1, Suit: 1}, {Rank: 2, Suit: 0}, {Rank: 2, Suit: 1}}
+	higherStraight3 := []Card{{Rank: 6, Suit: 0}, {Rank: 7, Suit: 1}, {Rank: 8, Suit: 2}}
+
+	tests := []struct {
+		name string
+		prev []Card
+		next []Card
+		want bool
+	}{
+		{"Straight vs Triple (Fail)", triple, straight3, false},
+		{"Triple vs Straight (Fail)", straight3, triple, false},
+		{"Straight vs 3-Pine (Fail)", pine3, straight6, false},
+		{"3-Pine vs Straight (Fail)", straight6, pine3, false},
+		{"Straight vs Smaller Straight", straight3, higherStraight3, true},
+		{"Invalid selection (Fail)", straight3, []Card{{Rank: 9, Suit: 0}, {Rank: 11, Suit: 1}, {Rank: 12, Suit: 2}}, false},
+	}
+
+	for _, tt := range tests {
+		if got := CanBeat(tt.prev, tt.next); got != tt.want {
+			t.Errorf("%s: CanBeat() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
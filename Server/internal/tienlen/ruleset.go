@@ -0,0 +1,324 @@
+package tienlen
+
+import "fmt"
+
+// Variant names a regional flavour of Tien Len rules.
+type Variant string
+
+const (
+	VariantSouthern Variant = "southern" // mien Nam: straights of any suit, consecutive pairs chop 2s
+	VariantNorthern Variant = "northern" // mien Bac: follow suit/colour, same-suit straights, only quads chop
+)
+
+// RuleSet captures everything that differs between Tien Len variants.
+// A Game is constructed with one and consults it for every rules decision.
+type RuleSet interface {
+	Variant() Variant
+
+	// IsValidSet reports whether the cards form a combination legal under this variant.
+	IsValidSet(cards []Card) bool
+
+	// CanBeat reports whether next may be played on top of prev.
+	CanBeat(prev, next []Card) bool
+
+	// Chops is the chop table: whether bomb may be played on target despite their different shapes.
+	Chops(target, bomb Combo) bool
+
+	// StartingIndex picks the index into turnOrder of the player who leads the first round.
+	StartingIndex(turnOrder []string, hands map[string][]Card, lastWinnerID string) int
+
+	// PlacementPoints returns the points awarded per finishing position (index 0 is 1st place).
+	PlacementPoints(playerCount int) []int
+
+	// InstantWin reports whether a freshly dealt hand wins on the spot (toi trang) and with which
+	// pattern, given the number of decks dealt.
+	InstantWin(hand []Card, decks int) (InstantWinPattern, bool)
+
+	// ChopPenalty values chopped cards (2s or a bomb) in penalty units.
+	ChopPenalty(target []Card) int
+
+	// LeftoverPenalty values the 2s and bombs a losing player is stuck with (thoi heo, thoi bom).
+	LeftoverPenalty(hand []Card) int
+
+	// CongPenalty is owed by a player who never played a card before the game ended.
+	CongPenalty(playerCount int) int
+}
+
+// RuleSetFor returns the rule set implementing the given variant.
+// An empty variant selects the Southern rules.
+func RuleSetFor(v Variant) (RuleSet, error) {
+	switch v {
+	case VariantSouthern, "":
+		return SouthernRules{}, nil
+	case VariantNorthern:
+		return NorthernRules{}, nil
+	default:
+		return nil, fmt.Errorf("unknown rule variant %q", v)
+	}
+}
+
+// SouthernRules implements Tien Len mien Nam, the default variant.
+type SouthernRules struct{}
+
+func (SouthernRules) Variant() Variant { return VariantSouthern }
+
+func (SouthernRules) IsValidSet(cards []Card) bool {
+	return Classify(cards).IsValid()
+}
+
+func (r SouthernRules) CanBeat(prevCards, newCards []Card) bool {
+	prev := Classify(prevCards)
+	next := Classify(newCards)
+	if !prev.IsValid() || !next.IsValid() {
+		return false
+	}
+	if next.Beats(prev) {
+		return true
+	}
+	return r.Chops(prev, next)
+}
+
+// Chops implements the Southern "Pig Chopping" table:
+//
+//	3-Pine: beats Single 2
+//	Quad:   beats Single 2, Pair 2, 3-Pine
+//	4-Pine: beats Single 2, Pair 2, Quad, 3-Pine
+//	5-Pine: beats Single 2, Pair 2, Quad, 3-Pine, 4-Pine
+//	N of a kind (two decks): beats any 2s, Quad, any Pine, fewer of a kind
+func (SouthernRules) Chops(target, bomb Combo) bool {
+	isSingle2 := target.Kind == ComboSingle && target.Top.Rank == rankTwo
+	isPair2 := target.Kind == ComboPair && target.Top.Rank == rankTwo
+
+	switch {
+	case bomb.Kind == ComboOfAKind:
+		return chopsAsOfAKind(target, bomb) || target.Kind == ComboConsecutivePairs
+	case bomb.Kind == ComboQuad:
+		return isSingle2 || isPair2 || target.Pairs() == 3
+	case bomb.Pairs() >= 4:
+		return isSingle2 || isPair2 || target.Kind == ComboQuad ||
+			(target.Kind == ComboConsecutivePairs && target.Pairs() < bomb.Pairs())
+	case bomb.Pairs() == 3:
+		return isSingle2
+	}
+	return false
+}
+
+func (SouthernRules) StartingIndex(turnOrder []string, hands map[string][]Card, lastWinnerID string) int {
+	return defaultStartingIndex(turnOrder, hands, lastWinnerID)
+}
+
+// PlacementPoints pays the top half of the table from the bottom half ("nhat an bet"),
+// e.g. +2, +1, -1, -2 for four players. The middle seat of an odd table breaks even.
+func (SouthernRules) PlacementPoints(playerCount int) []int {
+	points := make([]int, playerCount)
+	half := playerCount / 2
+	for i := 0; i < half; i++ {
+		points[i] = half - i
+		points[playerCount-1-i] = -(half - i)
+	}
+	return points
+}
+
+// InstantWin recognises every pattern: dragon, four 2s, five consecutive pairs and six pairs.
+// Four 2s and six pairs only count when a single deck is dealt.
+func (SouthernRules) InstantWin(hand []Card, decks int) (InstantWinPattern, bool) {
+	return matchInstantWin(hand, decks, []InstantWinPattern{
+		InstantWinDragon,
+		InstantWinFourTwos,
+		InstantWinFiveConsecutivePairs,
+		InstantWinSixPairs,
+	})
+}
+
+// ChopPenalty values 2s by colour, a 3-pine at 3, a quad at 4, longer pines at 2 per pair beyond
+// the first and N of a kind at 2N.
+func (SouthernRules) ChopPenalty(target []Card) int {
+	combo := Classify(target)
+	switch {
+	case combo.IsTwos():
+		return twosPenalty(target)
+	case combo.Kind == ComboOfAKind:
+		return 2 * combo.Length
+	case combo.Kind == ComboQuad:
+		return 4
+	case combo.Pairs() == 3:
+		return 3
+	case combo.Pairs() > 3:
+		return 2 * (combo.Pairs() - 1)
+	}
+	return 0
+}
+
+// LeftoverPenalty charges leftover 2s, quads and consecutive-pair runs at their chop values.
+func (r SouthernRules) LeftoverPenalty(hand []Card) int {
+	return twosPenalty(hand) + leftoverBombsPenalty(hand, r, true)
+}
+
+// CongPenalty charges one unit per player at the table.
+func (SouthernRules) CongPenalty(playerCount int) int {
+	return playerCount
+}
+
+// NorthernRules implements Tien Len mien Bac: plays must follow the suit (singles,
+// straights) or colour pattern (pairs) of the board, straights must be of one suit,
+// consecutive pairs are not playable and only quads chop 2s.
+type NorthernRules struct{}
+
+func (NorthernRules) Variant() Variant { return VariantNorthern }
+
+func (NorthernRules) IsValidSet(cards []Card) bool {
+	combo := Classify(cards)
+	switch combo.Kind {
+	case ComboInvalid, ComboConsecutivePairs:
+		return false
+	case ComboStraight:
+		return allSameSuit(cards)
+	}
+	return true
+}
+
+func (r NorthernRules) CanBeat(prevCards, newCards []Card) bool {
+	if !r.IsValidSet(prevCards) || !r.IsValidSet(newCards) {
+		return false
+	}
+	prev := Classify(prevCards)
+	next := Classify(newCards)
+	if next.Beats(prev) {
+		return followsSuit(prev, prevCards, newCards)
+	}
+	return r.Chops(prev, next)
+}
+
+// Chops only lets a quad chop a single 2 or a pair of 2s. With two decks, five or more of
+// a kind also chop quads and fewer of a kind.
+func (NorthernRules) Chops(target, bomb Combo) bool {
+	if bomb.Kind == ComboOfAKind {
+		return chopsAsOfAKind(target, bomb)
+	}
+	if bomb.Kind != ComboQuad {
+		return false
+	}
+	return (target.Kind == ComboSingle || target.Kind == ComboPair) && target.Top.Rank == rankTwo
+}
+
+func (NorthernRules) StartingIndex(turnOrder []string, hands map[string][]Card, lastWinnerID string) int {
+	return defaultStartingIndex(turnOrder, hands, lastWinnerID)
+}
+
+// PlacementPoints is winner-takes-all: every other player pays the 1st place one point.
+func (NorthernRules) PlacementPoints(playerCount int) []int {
+	points := make([]int, playerCount)
+	for i := range points {
+		points[i] = -1
+	}
+	if playerCount > 0 {
+		points[0] = playerCount - 1
+	}
+	return points
+}
+
+// InstantWin only recognises the dragon and four 2s, since pairs carry no chopping power in the North.
+// Four 2s only count when a single deck is dealt.
+func (NorthernRules) InstantWin(hand []Card, decks int) (InstantWinPattern, bool) {
+	return matchInstantWin(hand, decks, []InstantWinPattern{
+		InstantWinDragon,
+		InstantWinFourTwos,
+	})
+}
+
+// ChopPenalty values 2s by colour, a quad at 4 and N of a kind at 2N.
+func (NorthernRules) ChopPenalty(target []Card) int {
+	combo := Classify(target)
+	switch {
+	case combo.IsTwos():
+		return twosPenalty(target)
+	case combo.Kind == ComboOfAKind:
+		return 2 * combo.Length
+	case combo.Kind == ComboQuad:
+		return 4
+	}
+	return 0
+}
+
+// LeftoverPenalty charges leftover 2s and quads; consecutive pairs are not bombs in the North.
+func (r NorthernRules) LeftoverPenalty(hand []Card) int {
+	return twosPenalty(hand) + leftoverBombsPenalty(hand, r, false)
+}
+
+// CongPenalty charges one unit per player at the table.
+func (NorthernRules) CongPenalty(playerCount int) int {
+	return playerCount
+}
+
+// chopsAsOfAKind reports whether five or more of a kind chop target: any 2s, a quad,
+// or fewer cards of a kind.
+func chopsAsOfAKind(target, bomb Combo) bool {
+	switch target.Kind {
+	case ComboQuad:
+		return true
+	case ComboOfAKind:
+		return target.Length < bomb.Length
+	}
+	return target.IsTwos()
+}
+
+// followsSuit applies the Northern following rules to a same-shape play.
+// A 2 may be played on any lower single regardless of suit.
+func followsSuit(prev Combo, prevCards, newCards []Card) bool {
+	switch prev.Kind {
+	case ComboSingle:
+		return newCards[0].Rank == rankTwo || newCards[0].Suit == prevCards[0].Suit
+	case ComboPair:
+		return redCount(newCards) == redCount(prevCards)
+	case ComboStraight:
+		return newCards[0].Suit == prevCards[0].Suit
+	}
+	return true
+}
+
+// defaultStartingIndex lets the previous game's winner lead; if they are absent
+// the player holding the lowest card starts.
+func defaultStartingIndex(turnOrder []string, hands map[string][]Card, lastWinnerID string) int {
+	if lastWinnerID != "" {
+		for i, uid := range turnOrder {
+			if uid == lastWinnerID {
+				return i
+			}
+		}
+	}
+
+	lowestPower := int32(1000) // Start high
+	lowestPlayerIndex := 0
+	for i, uid := range turnOrder {
+		hand := hands[uid]
+		if len(hand) > 0 {
+			// Hands are sorted, so the first card is the smallest
+			power := cardPower(hand[0])
+			if power < lowestPower {
+				lowestPower = power
+				lowestPlayerIndex = i
+			}
+		}
+	}
+	return lowestPlayerIndex
+}
+
+func allSameSuit(cards []Card) bool {
+	for _, c := range cards {
+		if c.Suit != cards[0].Suit {
+			return false
+		}
+	}
+	return true
+}
+
+// redCount counts Diamonds and Hearts, used to match the colour pattern of pairs.
+func redCount(cards []Card) int {
+	n := 0
+	for _, c := range cards {
+		if c.Suit == 2 || c.Suit == 3 {
+			n++
+		}
+	}
+	return n
+}
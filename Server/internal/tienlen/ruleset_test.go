@@ -0,0 +1,103 @@
+package tienlen
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRuleSetFor(t *testing.T) {
+	for _, v := range []Variant{"", VariantSouthern, VariantNorthern} {
+		rules, err := RuleSetFor(v)
+		if err != nil {
+			t.Fatalf("RuleSetFor(%q) error: %v", v, err)
+		}
+		if v != "" && rules.Variant() != v {
+			t.Fatalf("RuleSetFor(%q) returned %s rules", v, rules.Variant())
+		}
+	}
+	if _, err := RuleSetFor("western"); err == nil {
+		t.Fatalf("expected error for unknown variant")
+	}
+}
+
+func TestNorthernRules(t *testing.T) {
+	rules := NorthernRules{}
+
+	mixedStraight := []Card{{Rank: 0, Suit: 0}, {Rank: 1, Suit: 1}, {Rank: 2, Suit: 0}}
+	spadeStraight := []Card{{Rank: 0, Suit: 0}, {Rank: 1, Suit: 0}, {Rank: 2, Suit: 0}}
+	higherSpadeStraight := []Card{{Rank: 1, Suit: 0}, {Rank: 2, Suit: 0}, {Rank: 3, Suit: 0}}
+	higherHeartStraight := []Card{{Rank: 1, Suit: 3}, {Rank: 2, Suit: 3}, {Rank: 3, Suit: 3}}
+	pine3 := []Card{{Rank: 0, Suit: 0}, {Rank: 0, Suit: 1}, {Rank: 1, Suit: 0}, {Rank: 1, Suit: 1}, {Rank: 2, Suit: 0}, {Rank: 2, Suit: 1}}
+
+	if rules.IsValidSet(mixedStraight) {
+		t.Errorf("mixed-suit straight should be invalid under northern rules")
+	}
+	if !rules.IsValidSet(spadeStraight) {
+		t.Errorf("same-suit straight should be valid under northern rules")
+	}
+	if rules.IsValidSet(pine3) {
+		t.Errorf("consecutive pairs should be invalid under northern rules")
+	}
+
+	single2 := []Card{{Rank: 12, Suit: 0}}
+	pair2 := []Card{{Rank: 12, Suit: 0}, {Rank: 12, Suit: 1}}
+	quad := []Card{{Rank: 6, Suit: 0}, {Rank: 6, Suit: 1}, {Rank: 6, Suit: 2}, {Rank: 6, Suit: 3}}
+
+	tests := []struct {
+		name string
+		prev []Card
+		next []Card
+		want bool
+	}{
+		{"Single same suit higher", []Card{{Rank: 3, Suit: 1}}, []Card{{Rank: 7, Suit: 1}}, true},
+		{"Single other suit (Fail)", []Card{{Rank: 3, Suit: 1}}, []Card{{Rank: 7, Suit: 2}}, false},
+		{"Two beats any single", []Card{{Rank: 11, Suit: 3}}, []Card{{Rank: 12, Suit: 0}}, true},
+		{"Pair same colours", []Card{{Rank: 3, Suit: 0}, {Rank: 3, Suit: 2}}, []Card{{Rank: 5, Suit: 1}, {Rank: 5, Suit: 3}}, true},
+		{"Pair different colours (Fail)", []Card{{Rank: 3, Suit: 0}, {Rank: 3, Suit: 1}}, []Card{{Rank: 5, Suit: 2}, {Rank: 5, Suit: 3}}, false},
+		{"Straight same suit", spadeStraight, higherSpadeStraight, true},
+		{"Straight other suit (Fail)", spadeStraight, higherHeartStraight, false},
+		{"Quad chops Single 2", single2, quad, true},
+		{"Quad chops Pair 2", pair2, quad, true},
+		{"Quad vs Single (Fail)", []Card{{Rank: 5, Suit: 0}}, quad, false},
+	}
+	for _, tt := range tests {
+		if got := rules.CanBeat(tt.prev, tt.next); got != tt.want {
+			t.Errorf("%s: CanBeat() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPlacementPoints(t *testing.T) {
+	tests := []struct {
+		rules RuleSet
+		n     int
+		want  []int
+	}{
+		{SouthernRules{}, 4, []int{2, 1, -1, -2}},
+		{SouthernRules{}, 3, []int{1, 0, -1}},
+		{SouthernRules{}, 2, []int{1, -1}},
+		{NorthernRules{}, 4, []int{3, -1, -1, -1}},
+	}
+	for _, tt := range tests {
+		if got := tt.rules.PlacementPoints(tt.n); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s PlacementPoints(%d) = %v, want %v", tt.rules.Variant(), tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestGameUsesRuleSet(t *testing.T) {
+	g := NewGameWithRules(NorthernRules{})
+	g.TurnOrder = []string{"p1", "p2"}
+	g.Hands = map[string][]Card{
+		"p1": {{Rank: 0, Suit: 0}, {Rank: 1, Suit: 1}, {Rank: 2, Suit: 0}, {Rank: 9, Suit: 0}},
+		"p2": {{Rank: 4, Suit: 0}},
+	}
+	g.isPlaying = true
+
+	if _, err := g.PlayCards("p1", []int{0, 1, 2}); err == nil {
+		t.Fatalf("expected mixed-suit straight to be rejected by northern rules")
+	}
+	if g.Snapshot().Variant != VariantNorthern {
+		t.Fatalf("expected snapshot to report northern variant, got %q", g.Snapshot().Variant)
+	}
+}
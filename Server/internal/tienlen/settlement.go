@@ -0,0 +1,202 @@
+package tienlen
+
+import "sort"
+
+// Settlement is the end-of-game result: full standings and the points each player wins or owes.
+type Settlement struct {
+	// Standings lists every player in finishing order; the last entry is the loser.
+	Standings []string
+	// Results holds one entry per player, in Standings order.
+	Results []PlayerResult
+}
+
+// PlayerResult is one player's line in the settlement. Point fields are signed:
+// penalties are negative for the player who pays and positive for the one who collects.
+type PlayerResult struct {
+	PlayerID        string
+	Place           int    // 1-based finishing position
+	Cong            bool   // Never played a card (cong)
+	Abandoned       bool   // Left mid-game; the rest of the hand was played for them
+	RemainingHand   []Card // Cards left in hand when the game ended
+	PlacementPoints int
+	CongPoints      int
+	LeftoverPoints  int // Leftover 2s and bombs (thoi heo, thoi bom)
+	ChopPoints      int // Net of chops made and suffered
+	Total           int
+}
+
+// Result returns the line for the given player, if present.
+func (s Settlement) Result(playerID string) (PlayerResult, bool) {
+	for _, r := range s.Results {
+		if r.PlayerID == playerID {
+			return r, true
+		}
+	}
+	return PlayerResult{}, false
+}
+
+// Settle computes the settlement for a finished game. Placement points come from the
+// rule set; players who never played (cong) and players stuck with 2s or bombs pay the
+// winner, and every chop is paid by its victim to its chopper. An instant win is settled
+// as the winner against everyone else: the others share 2nd place and pay one point each.
+func (g *Game) Settle() Settlement {
+	standings := g.standings()
+	points := g.rules.PlacementPoints(len(standings))
+	if g.endedByInstantWin {
+		points = instantWinPoints(len(standings))
+	}
+	results := make([]PlayerResult, len(standings))
+	index := make(map[string]int, len(standings))
+
+	for i, uid := range standings {
+		index[uid] = i
+		place := i + 1
+		if g.endedByInstantWin && i > 0 {
+			place = 2
+		}
+		results[i] = PlayerResult{
+			PlayerID:        uid,
+			Place:           place,
+			RemainingHand:   g.HandOf(uid),
+			PlacementPoints: points[i],
+			Abandoned:       g.abandoned[uid],
+		}
+	}
+
+	// Penalties for leftovers and cong are collected by the winner. They do not
+	// apply when the game ended on an instant win, since nobody had a chance to play.
+	if len(standings) > 0 && !g.endedByInstantWin {
+		winner := &results[0]
+		for i := 1; i < len(results); i++ {
+			r := &results[i]
+			if g.FinishedPlayers[r.PlayerID] {
+				continue
+			}
+			if g.CardsPlayed[r.PlayerID] == 0 {
+				r.Cong = true
+				penalty := g.rules.CongPenalty(len(standings))
+				r.CongPoints -= penalty
+				winner.CongPoints += penalty
+			}
+			if penalty := g.rules.LeftoverPenalty(r.RemainingHand); penalty > 0 {
+				r.LeftoverPoints -= penalty
+				winner.LeftoverPoints += penalty
+			}
+		}
+	}
+
+	for _, chop := range g.Chops {
+		if i, ok := index[chop.VictimID]; ok {
+			results[i].ChopPoints -= chop.Penalty
+		}
+		if i, ok := index[chop.ChopperID]; ok {
+			results[i].ChopPoints += chop.Penalty
+		}
+	}
+
+	for i := range results {
+		r := &results[i]
+		r.Total = r.PlacementPoints + r.CongPoints + r.LeftoverPoints + r.ChopPoints
+	}
+
+	return Settlement{Standings: standings, Results: results}
+}
+
+// instantWinPoints has every other player pay the winner of an instant win one point.
+func instantWinPoints(playerCount int) []int {
+	points := make([]int, playerCount)
+	for i := range points {
+		points[i] = -1
+	}
+	if playerCount > 0 {
+		points[0] = playerCount - 1
+	}
+	return points
+}
+
+// standings orders finished players by finishing position, followed by the players
+// still holding cards: fewest cards first, players who never played (cong) last,
+// and ties broken by turn order.
+func (g *Game) standings() []string {
+	out := append([]string(nil), g.Winners...)
+
+	remaining := make([]string, 0, len(g.TurnOrder)-len(g.Winners))
+	seat := make(map[string]int, len(g.TurnOrder))
+	for i, uid := range g.TurnOrder {
+		seat[uid] = i
+		if !g.FinishedPlayers[uid] && !containsID(g.Winners, uid) {
+			remaining = append(remaining, uid)
+		}
+	}
+	sort.SliceStable(remaining, func(i, j int) bool {
+		a, b := remaining[i], remaining[j]
+		congA, congB := g.CardsPlayed[a] == 0, g.CardsPlayed[b] == 0
+		if congA != congB {
+			return !congA
+		}
+		if len(g.Hands[a]) != len(g.Hands[b]) {
+			return len(g.Hands[a]) < len(g.Hands[b])
+		}
+		return seat[a] < seat[b]
+	})
+	return append(out, remaining...)
+}
+
+// leftoverBombsPenalty values the quads (or more of a kind) and consecutive-pair runs left
+// in a hand, using the rule set's chop values.
+func leftoverBombsPenalty(hand []Card, rules RuleSet, withPines bool) int {
+	counts := rankCounts(hand)
+	total := 0
+	for r := int32(0); r < rankTwo; r++ {
+		if counts[r] >= 4 {
+			total += rules.ChopPenalty(sameRankOf(r, counts[r]))
+		}
+	}
+	if !withPines {
+		return total
+	}
+
+	run := 0
+	flush := func() {
+		if run >= 3 {
+			total += rules.ChopPenalty(consecutivePairsOf(run))
+		}
+		run = 0
+	}
+	for r := int32(0); r < rankTwo; r++ {
+		if counts[r] == 2 || counts[r] == 3 { // Ranks held as a quad are already counted
+			run++
+		} else {
+			flush()
+		}
+	}
+	flush()
+	return total
+}
+
+// sameRankOf builds a representative set of n cards of rank r for valuation.
+func sameRankOf(r int32, n int) []Card {
+	cards := make([]Card, n)
+	for i := range cards {
+		cards[i] = Card{Rank: r, Suit: int32(i % 4)}
+	}
+	return cards
+}
+
+// consecutivePairsOf builds a representative run of n consecutive pairs for valuation.
+func consecutivePairsOf(n int) []Card {
+	cards := make([]Card, 0, n*2)
+	for r := int32(0); r < int32(n); r++ {
+		cards = append(cards, Card{Rank: r, Suit: 0}, Card{Rank: r, Suit: 1})
+	}
+	return cards
+}
+
+func containsID(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package tienlen
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSettlementStandingsAndPoints(t *testing.T) {
+	players := []string{"p1", "p2", "p3", "p4"}
+	g := setupDeterministicGame(players, "p1", map[string][]Card{
+		"p1": {{Rank: 0, Suit: 0}},
+		"p2": {{Rank: 1, Suit: 0}},
+		"p3": {{Rank: 2, Suit: 0}},
+		"p4": {{Rank: 3, Suit: 0}, {Rank: 12, Suit: 3}}, // Loser stuck with a red 2
+	})
+	g.CardsPlayed["p4"] = 5 // p4 has played before, so this is not cong
+
+	var gameOver *GameOver
+	var finished []PlayerFinished
+	for _, uid := range []string{"p1", "p2", "p3"} {
+		events, err := g.PlayCards(uid, []int{0})
+		if err != nil {
+			t.Fatalf("%s PlayCards error: %v", uid, err)
+		}
+		processEvents(events, &gameOver, &finished)
+	}
+	if gameOver == nil {
+		t.Fatalf("expected GameOver after third player finished")
+	}
+
+	settlement := gameOver.Settlement
+	if !reflect.DeepEqual(settlement.Standings, players) {
+		t.Fatalf("standings = %v, want %v", settlement.Standings, players)
+	}
+
+	loser, ok := settlement.Result("p4")
+	if !ok {
+		t.Fatalf("expected a result line for the loser")
+	}
+	if loser.Place != 4 || loser.PlacementPoints != -2 || loser.LeftoverPoints != -2 || loser.Cong {
+		t.Fatalf("unexpected loser line: %+v", loser)
+	}
+	if len(loser.RemainingHand) != 2 {
+		t.Fatalf("expected loser's remaining hand to be revealed, got %v", loser.RemainingHand)
+	}
+	winner, _ := settlement.Result("p1")
+	if winner.Total != 2+2 {
+		t.Fatalf("expected winner to collect placement and leftover points, got %+v", winner)
+	}
+	assertZeroSum(t, settlement)
+}
+
+func TestSettlementCongAndChops(t *testing.T) {
+	g := setupDeterministicGame([]string{"p1", "p2", "p3"}, "p1", map[string][]Card{
+		"p1": {},
+		"p2": {{Rank: 4, Suit: 0}},
+		"p3": {{Rank: 5, Suit: 0}, {Rank: 6, Suit: 0}},
+	})
+	g.Winners = []string{"p1"}
+	g.FinishedPlayers["p1"] = true
+	g.CardsPlayed = map[string]int{"p1": 13, "p3": 11} // p2 never played
+	g.Chops = []ChopOccurred{{ChopperID: "p2", VictimID: "p3", Penalty: 3}}
+
+	settlement := g.Settle()
+	if want := []string{"p1", "p3", "p2"}; !reflect.DeepEqual(settlement.Standings, want) {
+		t.Fatalf("standings = %v, want %v (cong player last)", settlement.Standings, want)
+	}
+
+	cong, _ := settlement.Result("p2")
+	if !cong.Cong || cong.CongPoints != -3 || cong.ChopPoints != 3 {
+		t.Fatalf("unexpected cong line: %+v", cong)
+	}
+	victim, _ := settlement.Result("p3")
+	if victim.Cong || victim.ChopPoints != -3 {
+		t.Fatalf("unexpected chop victim line: %+v", victim)
+	}
+	winner, _ := settlement.Result("p1")
+	if winner.CongPoints != 3 {
+		t.Fatalf("expected winner to collect the cong penalty, got %+v", winner)
+	}
+	assertZeroSum(t, settlement)
+}
+
+func TestSettlementAfterInstantWinSkipsPenalties(t *testing.T) {
+	g := setupDeterministicGame([]string{"p1", "p2"}, "p1", map[string][]Card{
+		"p1": {{Rank: 12, Suit: 0}, {Rank: 12, Suit: 1}, {Rank: 12, Suit: 2}, {Rank: 12, Suit: 3}},
+		"p2": {{Rank: 5, Suit: 0}, {Rank: 5, Suit: 1}, {Rank: 5, Suit: 2}, {Rank: 5, Suit: 3}}, // Leftover quad
+	})
+	g.handSize = DefaultHandSize
+
+	win, ok := g.detectInstantWin()
+	if !ok {
+		t.Fatalf("expected four 2s to win instantly")
+	}
+	g.Winners = []string{win.PlayerID}
+	g.endedByInstantWin = true
+
+	loser, _ := g.Settle().Result("p2")
+	if loser.Cong || loser.CongPoints != 0 || loser.LeftoverPoints != 0 || loser.Total != -1 {
+		t.Fatalf("expected only placement points after an instant win, got %+v", loser)
+	}
+}
+
+func assertZeroSum(t *testing.T, s Settlement) {
+	t.Helper()
+	sum := 0
+	for _, r := range s.Results {
+		sum += r.Total
+	}
+	if sum != 0 {
+		t.Fatalf("expected settlement to be zero-sum, got %d (%+v)", sum, s.Results)
+	}
+}
+
+func TestSettlementAfterInstantWinTreatsOthersAlike(t *testing.T) {
+	g := setupDeterministicGame([]string{"p1", "p2", "p3", "p4"}, "p1", map[string][]Card{
+		"p1": {{Rank: 5, Suit: 0}, {Rank: 6, Suit: 0}},
+		"p2": {{Rank: 12, Suit: 0}, {Rank: 12, Suit: 1}, {Rank: 12, Suit: 2}, {Rank: 12, Suit: 3}},
+		"p3": {{Rank: 7, Suit: 0}, {Rank: 8, Suit: 0}},
+		"p4": {{Rank: 9, Suit: 0}, {Rank: 10, Suit: 0}},
+	})
+	g.Winners = []string{"p2"}
+	g.endedByInstantWin = true
+
+	s := g.Settle()
+	if winner, _ := s.Result("p2"); winner.Place != 1 || winner.Total != 3 {
+		t.Fatalf("expected the instant winner to collect from everyone, got %+v", winner)
+	}
+	for _, uid := range []string{"p1", "p3", "p4"} {
+		if r, _ := s.Result(uid); r.Place != 2 || r.PlacementPoints != -1 || r.Total != -1 {
+			t.Fatalf("expected %s to share 2nd place and pay one point, got %+v", uid, r)
+		}
+	}
+	assertZeroSum(t, s)
+}
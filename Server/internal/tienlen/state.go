@@ -0,0 +1,175 @@
+package tienlen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+)
+
+// StateVersion is the layout version of GameState. Bump it on changes that older
+// servers cannot read; new optional fields do not need a bump.
+const StateVersion = 1
+
+// GameState is the complete state of a Game in serializable form, used for crash recovery,
+// admin inspection and moving a table between nodes. It contains every hand and, for a
+// committed deal, the secret server seed, so it must never be sent to players.
+type GameState struct {
+	Version   int     `json:"version"`
+	Variant   Variant `json:"variant"`
+	Seed      int64   `json:"seed"`
+	IsPlaying bool    `json:"is_playing"`
+	OwnerID   string  `json:"owner_id"`
+
+	Players      []string          `json:"players"` // In the order given to Start
+	TurnOrder    []string          `json:"turn_order"`
+	CurrentIdx   int               `json:"current_idx"`
+	Hands        map[string][]Card `json:"hands"`
+	HandVersions map[string]int    `json:"hand_versions"`
+	Deck         []Card            `json:"deck"`
+	Commitment   *DealCommitment   `json:"commitment,omitempty"`
+	Deal         DealConfig        `json:"deal"`
+	HandSize     int               `json:"hand_size"`
+	OpeningCard  *Card             `json:"opening_card,omitempty"`
+
+	Board         []Card          `json:"board"`
+	LastActor     string          `json:"last_actor"`
+	RoundSkippers map[string]bool `json:"round_skippers"`
+	ChopChainOpen bool            `json:"chop_chain_open"`
+
+	Winners           []string        `json:"winners"`
+	FinishedPlayers   map[string]bool `json:"finished_players"`
+	Chops             []ChopOccurred  `json:"chops"`
+	CardsPlayed       map[string]int  `json:"cards_played"`
+	EndedByInstantWin bool            `json:"ended_by_instant_win"`
+	Abandoned         map[string]bool `json:"abandoned,omitempty"`
+
+	Log []LogEntry `json:"log"`
+}
+
+// State returns a deep copy of the game's complete state.
+func (g *Game) State() GameState {
+	state := GameState{
+		Version:           StateVersion,
+		Variant:           g.rules.Variant(),
+		Seed:              g.Seed,
+		IsPlaying:         g.isPlaying,
+		OwnerID:           g.OwnerID,
+		Players:           append([]string(nil), g.players...),
+		TurnOrder:         append([]string(nil), g.TurnOrder...),
+		CurrentIdx:        g.CurrentIdx,
+		Hands:             g.HandsCopy(),
+		HandVersions:      copyCounts(g.HandVersions),
+		Deck:              append([]Card(nil), g.Deck...),
+		Deal:              g.Deal,
+		HandSize:          g.handSize,
+		OpeningCard:       copyCard(g.openingCard),
+		Board:             append([]Card(nil), g.Board...),
+		LastActor:         g.LastActor,
+		RoundSkippers:     copySet(g.RoundSkippers),
+		ChopChainOpen:     g.chopChainOpen,
+		Winners:           append([]string(nil), g.Winners...),
+		FinishedPlayers:   copySet(g.FinishedPlayers),
+		Chops:             append([]ChopOccurred(nil), g.Chops...),
+		CardsPlayed:       copyCounts(g.CardsPlayed),
+		EndedByInstantWin: g.endedByInstantWin,
+		Abandoned:         copySet(g.abandoned),
+		Log:               g.Log(),
+	}
+	if g.commitment != nil {
+		c := *g.commitment
+		state.Commitment = &c
+	}
+	return state
+}
+
+// RestoreGame rebuilds a game from its state. The random source is re-seeded and advanced
+// past the deal, so the restored game is indistinguishable from the original.
+func RestoreGame(state GameState) (*Game, error) {
+	if state.Version != StateVersion {
+		return nil, fmt.Errorf("unsupported game state version %d", state.Version)
+	}
+	rules, err := RuleSetFor(state.Variant)
+	if err != nil {
+		return nil, err
+	}
+	if len(state.TurnOrder) > 0 && (state.CurrentIdx < 0 || state.CurrentIdx >= len(state.TurnOrder)) {
+		return nil, fmt.Errorf("current index %d out of range", state.CurrentIdx)
+	}
+
+	g := NewGameWithRules(rules)
+	g.Seed = state.Seed
+	g.isPlaying = state.IsPlaying
+	g.OwnerID = state.OwnerID
+	g.players = append([]string(nil), state.Players...)
+	g.TurnOrder = append([]string(nil), state.TurnOrder...)
+	g.CurrentIdx = state.CurrentIdx
+	for uid, hand := range state.Hands {
+		g.Hands[uid] = append([]Card(nil), hand...)
+	}
+	g.HandVersions = copyCounts(state.HandVersions)
+	g.Deck = append([]Card(nil), state.Deck...)
+	g.Deal = state.Deal
+	g.handSize = state.HandSize
+	g.openingCard = copyCard(state.OpeningCard)
+	g.Board = append([]Card(nil), state.Board...)
+	if len(g.Board) == 0 {
+		g.Board = nil
+	}
+	g.LastActor = state.LastActor
+	g.RoundSkippers = copySet(state.RoundSkippers)
+	g.chopChainOpen = state.ChopChainOpen
+	g.Winners = append(g.Winners, state.Winners...)
+	g.FinishedPlayers = copySet(state.FinishedPlayers)
+	g.Chops = append([]ChopOccurred(nil), state.Chops...)
+	g.CardsPlayed = copyCounts(state.CardsPlayed)
+	g.endedByInstantWin = state.EndedByInstantWin
+	g.abandoned = copySet(state.Abandoned)
+	g.log = append([]LogEntry(nil), state.Log...)
+	if state.Commitment != nil {
+		c := *state.Commitment
+		g.commitment = &c
+	}
+
+	if len(g.players) > 0 {
+		g.rng = rand.New(rand.NewSource(g.Seed))
+		shuffleDeal(g.rng, len(g.players))
+	}
+	return g, nil
+}
+
+// MarshalJSON encodes the game's complete state.
+func (g *Game) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.State())
+}
+
+// UnmarshalJSON restores a game encoded by MarshalJSON.
+func (g *Game) UnmarshalJSON(data []byte) error {
+	var state GameState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	restored, err := RestoreGame(state)
+	if err != nil {
+		return err
+	}
+	*g = *restored
+	return nil
+}
+
+func copySet(in map[string]bool) map[string]bool {
+	out := make(map[string]bool, len(in))
+	for k, v := range in {
+		if v {
+			out[k] = true
+		}
+	}
+	return out
+}
+
+func copyCounts(in map[string]int) map[string]int {
+	out := make(map[string]int, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
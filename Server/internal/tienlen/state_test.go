@@ -0,0 +1,64 @@
+package tienlen
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStateRoundTripsThroughJSON(t *testing.T) {
+	g := NewGameWithRules(NorthernRules{})
+	commitment := CommitDeal(strings.Repeat("cd", 32), "p1=x")
+	if _, err := g.StartCommitted(commitment, []string{"p1", "p2", "p3"}, "p1", ""); err != nil {
+		t.Fatalf("StartCommitted error: %v", err)
+	}
+	// Lead with the lowest card, then let the next player pass so the state has a board and a skipper.
+	leader := g.TurnOrder[g.CurrentIdx]
+	if _, err := g.PlayCards(leader, []int{0}); err != nil {
+		t.Fatalf("PlayCards error: %v", err)
+	}
+	if _, err := g.Pass(g.TurnOrder[g.CurrentIdx]); err != nil {
+		t.Fatalf("Pass error: %v", err)
+	}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	var restored Game
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+
+	if want, got := g.State(), restored.State(); !reflect.DeepEqual(want, got) {
+		t.Fatalf("state changed across JSON:\nwant %+v\ngot  %+v", want, got)
+	}
+	if !restored.IsPlaying() || restored.Rules().Variant() != VariantNorthern {
+		t.Fatalf("expected a playing northern game after restore")
+	}
+
+	// Both games accept the same next move and stay identical.
+	next := g.TurnOrder[g.CurrentIdx]
+	_, errA := g.Pass(next)
+	_, errB := restored.Pass(next)
+	if (errA == nil) != (errB == nil) || !reflect.DeepEqual(g.State(), restored.State()) {
+		t.Fatalf("restored game diverged from the original: %v / %v", errA, errB)
+	}
+}
+
+func TestRestoreGameRejectsBadState(t *testing.T) {
+	tests := []struct {
+		name  string
+		state GameState
+	}{
+		{"unknown version", GameState{Version: StateVersion + 1}},
+		{"unknown variant", GameState{Version: StateVersion, Variant: "western"}},
+		{"turn out of range", GameState{Version: StateVersion, TurnOrder: []string{"p1"}, CurrentIdx: 1}},
+	}
+	for _, tt := range tests {
+		if _, err := RestoreGame(tt.state); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
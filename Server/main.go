@@ -19,6 +19,9 @@ func InitModule(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runti
 	if err := initializer.RegisterRpc("create_match", api.RpcCreateMatch); err != nil {
 		return err
 	}
+	if err := initializer.RegisterRpc("join_by_code", api.RpcJoinByCode); err != nil {
+		return err
+	}
 	if err := initializer.RegisterRpc("quick_match", api.RpcQuickMatch); err != nil {
 		return err
 	}
@@ -37,6 +37,14 @@ const (
 	OpCode_OP_HAND_UPDATE        OpCode = 9  // Server -> Client (Update player's hand)
 	OpCode_OP_PASS               OpCode = 10 // Client -> Server (Player passes this round)
 	OpCode_OP_ROUND_END          OpCode = 11 // Server -> Client (Round finished, table cleared)
+	OpCode_OP_INSTANT_WIN        OpCode = 12 // Server -> Client (Dealt hand wins on the spot, hand revealed)
+	OpCode_OP_CHOP               OpCode = 13 // Server -> Client (A bomb chopped 2s or another bomb)
+	OpCode_OP_HINT_REQUEST       OpCode = 14 // Client -> Server (Ask for suggested plays)
+	OpCode_OP_HINT               OpCode = 15 // Server -> Client (Suggested plays, best first)
+	OpCode_OP_ADD_BOT            OpCode = 16 // Client -> Server (Owner seats a bot in a free seat)
+	OpCode_OP_REMOVE_BOT         OpCode = 17 // Client -> Server (Owner removes a bot from its seat)
+	OpCode_OP_TURN_TIMED_OUT     OpCode = 18 // Server -> Client (A turn clock ran out and the server moved for the player)
+	OpCode_OP_READY              OpCode = 19 // Client -> Server (Seated player is ready for the next game; ReadyRequest)
 )
 
 // Enum value maps for OpCode.
@@ -54,6 +62,14 @@ var (
 		9:  "OP_HAND_UPDATE",
 		10: "OP_PASS",
 		11: "OP_ROUND_END",
+		12: "OP_INSTANT_WIN",
+		13: "OP_CHOP",
+		14: "OP_HINT_REQUEST",
+		15: "OP_HINT",
+		16: "OP_ADD_BOT",
+		17: "OP_REMOVE_BOT",
+		18: "OP_TURN_TIMED_OUT",
+		19: "OP_READY",
 	}
 	OpCode_value = map[string]int32{
 		"OP_UNKNOWN":            0,
@@ -68,6 +84,14 @@ var (
 		"OP_HAND_UPDATE":        9,
 		"OP_PASS":               10,
 		"OP_ROUND_END":          11,
+		"OP_INSTANT_WIN":        12,
+		"OP_CHOP":               13,
+		"OP_HINT_REQUEST":       14,
+		"OP_HINT":               15,
+		"OP_ADD_BOT":            16,
+		"OP_REMOVE_BOT":         17,
+		"OP_TURN_TIMED_OUT":     18,
+		"OP_READY":              19,
 	}
 )
 
@@ -154,6 +178,7 @@ func (x *Card) GetRank() int32 {
 type HandUpdatePacket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hand          []*Card                `protobuf:"bytes,1,rep,name=hand,proto3" json:"hand,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Increases every time the hand changes; echo it in PlayCardRequest.hand_version
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -195,13 +220,26 @@ func (x *HandUpdatePacket) GetHand() []*Card {
 	return nil
 }
 
+func (x *HandUpdatePacket) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type MatchStartPacket struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hand          []*Card                `protobuf:"bytes,1,rep,name=hand,proto3" json:"hand,omitempty"`                            // Your cards
-	PlayerIds     []string               `protobuf:"bytes,2,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"` // Turn order
-	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`       // The ID of the current match owner
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hand           []*Card                `protobuf:"bytes,1,rep,name=hand,proto3" json:"hand,omitempty"`                                           // Your cards
+	PlayerIds      []string               `protobuf:"bytes,2,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`                // Players dealt in, by seat
+	OwnerId        string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                      // The ID of the current match owner
+	DealCommitment string                 `protobuf:"bytes,4,opt,name=deal_commitment,json=dealCommitment,proto3" json:"deal_commitment,omitempty"` // SHA-256 (hex) of the server seed and client entropy; revealed at game over
+	ClientEntropy  string                 `protobuf:"bytes,5,opt,name=client_entropy,json=clientEntropy,proto3" json:"client_entropy,omitempty"`    // Entropy contributed by the players through join metadata or ReadyRequest
+	HandVersion    int32                  `protobuf:"varint,6,opt,name=hand_version,json=handVersion,proto3" json:"hand_version,omitempty"`         // Version of the dealt hand (see HandUpdatePacket.version)
+	HandSize       int32                  `protobuf:"varint,7,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"`                  // Cards dealt to each player
+	OpeningCard    *Card                  `protobuf:"bytes,8,opt,name=opening_card,json=openingCard,proto3" json:"opening_card,omitempty"`          // Small tables: the first play must include this card
+	TurnOrder      []string               `protobuf:"bytes,9,rep,name=turn_order,json=turnOrder,proto3" json:"turn_order,omitempty"`                // player_ids in the order turns are taken, from the first player
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *MatchStartPacket) Reset() {
@@ -255,9 +293,54 @@ func (x *MatchStartPacket) GetOwnerId() string {
 	return ""
 }
 
+func (x *MatchStartPacket) GetDealCommitment() string {
+	if x != nil {
+		return x.DealCommitment
+	}
+	return ""
+}
+
+func (x *MatchStartPacket) GetClientEntropy() string {
+	if x != nil {
+		return x.ClientEntropy
+	}
+	return ""
+}
+
+func (x *MatchStartPacket) GetHandVersion() int32 {
+	if x != nil {
+		return x.HandVersion
+	}
+	return 0
+}
+
+func (x *MatchStartPacket) GetHandSize() int32 {
+	if x != nil {
+		return x.HandSize
+	}
+	return 0
+}
+
+func (x *MatchStartPacket) GetOpeningCard() *Card {
+	if x != nil {
+		return x.OpeningCard
+	}
+	return nil
+}
+
+func (x *MatchStartPacket) GetTurnOrder() []string {
+	if x != nil {
+		return x.TurnOrder
+	}
+	return nil
+}
+
 type GameOverPacket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WinnerId      string                 `protobuf:"bytes,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Standings     []string               `protobuf:"bytes,2,rep,name=standings,proto3" json:"standings,omitempty"` // Finishing order; the last entry lost
+	Results       []*PlayerResult        `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`     // Settlement lines, in standings order
+	Deal          *DealReveal            `protobuf:"bytes,4,opt,name=deal,proto3" json:"deal,omitempty"`           // Reveals the committed deal so it can be verified
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -299,27 +382,55 @@ func (x *GameOverPacket) GetWinnerId() string {
 	return ""
 }
 
-type RoundEndPacket struct {
+func (x *GameOverPacket) GetStandings() []string {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *GameOverPacket) GetResults() []*PlayerResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GameOverPacket) GetDeal() *DealReveal {
+	if x != nil {
+		return x.Deal
+	}
+	return nil
+}
+
+type DealReveal struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WinnerId      string                 `protobuf:"bytes,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"` // Player who wins this round
+	ServerSeed    string                 `protobuf:"bytes,1,opt,name=server_seed,json=serverSeed,proto3" json:"server_seed,omitempty"` // Hex; hashed with client_entropy it must equal the commitment
+	ClientEntropy string                 `protobuf:"bytes,2,opt,name=client_entropy,json=clientEntropy,proto3" json:"client_entropy,omitempty"`
+	Commitment    string                 `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	PlayerIds     []string               `protobuf:"bytes,4,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"` // Player order the deal was computed from
+	Deck          []*Card                `protobuf:"bytes,5,rep,name=deck,proto3" json:"deck,omitempty"`                            // Full deck order; hands are dealt in hand_size blocks in turn order
+	SeedHash      string                 `protobuf:"bytes,6,opt,name=seed_hash,json=seedHash,proto3" json:"seed_hash,omitempty"`    // SHA-256 (hex) of server_seed, published as next_seed_hash before the deal
+	HandSize      int32                  `protobuf:"varint,7,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"`   // Cards dealt to each player
+	Direction     string                 `protobuf:"bytes,8,opt,name=direction,proto3" json:"direction,omitempty"`                  // "counter_clockwise" or "clockwise", the way the deal went round
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoundEndPacket) Reset() {
-	*x = RoundEndPacket{}
+func (x *DealReveal) Reset() {
+	*x = DealReveal{}
 	mi := &file_game_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoundEndPacket) String() string {
+func (x *DealReveal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoundEndPacket) ProtoMessage() {}
+func (*DealReveal) ProtoMessage() {}
 
-func (x *RoundEndPacket) ProtoReflect() protoreflect.Message {
+func (x *DealReveal) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -331,43 +442,97 @@ func (x *RoundEndPacket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoundEndPacket.ProtoReflect.Descriptor instead.
-func (*RoundEndPacket) Descriptor() ([]byte, []int) {
+// Deprecated: Use DealReveal.ProtoReflect.Descriptor instead.
+func (*DealReveal) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RoundEndPacket) GetWinnerId() string {
+func (x *DealReveal) GetServerSeed() string {
 	if x != nil {
-		return x.WinnerId
+		return x.ServerSeed
 	}
 	return ""
 }
 
-type MatchStatePacket struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	IsPlaying      bool                   `protobuf:"varint,1,opt,name=is_playing,json=isPlaying,proto3" json:"is_playing,omitempty"`
-	OwnerId        string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Board          []*Card                `protobuf:"bytes,3,rep,name=board,proto3" json:"board,omitempty"`
-	ActivePlayerId string                 `protobuf:"bytes,4,opt,name=active_player_id,json=activePlayerId,proto3" json:"active_player_id,omitempty"`
-	PlayerIds      []string               `protobuf:"bytes,5,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"` // Who is currently playing
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *DealReveal) GetClientEntropy() string {
+	if x != nil {
+		return x.ClientEntropy
+	}
+	return ""
 }
 
-func (x *MatchStatePacket) Reset() {
-	*x = MatchStatePacket{}
+func (x *DealReveal) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *DealReveal) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *DealReveal) GetDeck() []*Card {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+func (x *DealReveal) GetSeedHash() string {
+	if x != nil {
+		return x.SeedHash
+	}
+	return ""
+}
+
+func (x *DealReveal) GetHandSize() int32 {
+	if x != nil {
+		return x.HandSize
+	}
+	return 0
+}
+
+func (x *DealReveal) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type PlayerResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId        string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Place           int32                  `protobuf:"varint,2,opt,name=place,proto3" json:"place,omitempty"`                                     // 1-based finishing position
+	Cong            bool                   `protobuf:"varint,3,opt,name=cong,proto3" json:"cong,omitempty"`                                       // Never played a card
+	RemainingHand   []*Card                `protobuf:"bytes,4,rep,name=remaining_hand,json=remainingHand,proto3" json:"remaining_hand,omitempty"` // Cards left when the game ended
+	PlacementPoints int32                  `protobuf:"varint,5,opt,name=placement_points,json=placementPoints,proto3" json:"placement_points,omitempty"`
+	CongPoints      int32                  `protobuf:"varint,6,opt,name=cong_points,json=congPoints,proto3" json:"cong_points,omitempty"`
+	LeftoverPoints  int32                  `protobuf:"varint,7,opt,name=leftover_points,json=leftoverPoints,proto3" json:"leftover_points,omitempty"` // Leftover 2s and bombs (thoi heo, thoi bom)
+	ChopPoints      int32                  `protobuf:"varint,8,opt,name=chop_points,json=chopPoints,proto3" json:"chop_points,omitempty"`
+	Total           int32                  `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`
+	Abandoned       bool                   `protobuf:"varint,10,opt,name=abandoned,proto3" json:"abandoned,omitempty"` // Left mid-game; a server bot played the rest of the hand
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerResult) Reset() {
+	*x = PlayerResult{}
 	mi := &file_game_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchStatePacket) String() string {
+func (x *PlayerResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchStatePacket) ProtoMessage() {}
+func (*PlayerResult) ProtoMessage() {}
 
-func (x *MatchStatePacket) ProtoReflect() protoreflect.Message {
+func (x *PlayerResult) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -379,67 +544,104 @@ func (x *MatchStatePacket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchStatePacket.ProtoReflect.Descriptor instead.
-func (*MatchStatePacket) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerResult.ProtoReflect.Descriptor instead.
+func (*PlayerResult) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *MatchStatePacket) GetIsPlaying() bool {
+func (x *PlayerResult) GetPlayerId() string {
 	if x != nil {
-		return x.IsPlaying
+		return x.PlayerId
 	}
-	return false
+	return ""
 }
 
-func (x *MatchStatePacket) GetOwnerId() string {
+func (x *PlayerResult) GetPlace() int32 {
 	if x != nil {
-		return x.OwnerId
+		return x.Place
 	}
-	return ""
+	return 0
 }
 
-func (x *MatchStatePacket) GetBoard() []*Card {
+func (x *PlayerResult) GetCong() bool {
 	if x != nil {
-		return x.Board
+		return x.Cong
+	}
+	return false
+}
+
+func (x *PlayerResult) GetRemainingHand() []*Card {
+	if x != nil {
+		return x.RemainingHand
 	}
 	return nil
 }
 
-func (x *MatchStatePacket) GetActivePlayerId() string {
+func (x *PlayerResult) GetPlacementPoints() int32 {
 	if x != nil {
-		return x.ActivePlayerId
+		return x.PlacementPoints
 	}
-	return ""
+	return 0
 }
 
-func (x *MatchStatePacket) GetPlayerIds() []string {
+func (x *PlayerResult) GetCongPoints() int32 {
 	if x != nil {
-		return x.PlayerIds
+		return x.CongPoints
 	}
-	return nil
+	return 0
 }
 
-type PlayCardRequest struct {
+func (x *PlayerResult) GetLeftoverPoints() int32 {
+	if x != nil {
+		return x.LeftoverPoints
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetChopPoints() int32 {
+	if x != nil {
+		return x.ChopPoints
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetAbandoned() bool {
+	if x != nil {
+		return x.Abandoned
+	}
+	return false
+}
+
+type InstantWinPacket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CardIndices   []int32                `protobuf:"varint,1,rep,packed,name=card_indices,json=cardIndices,proto3" json:"card_indices,omitempty"` // Indices of cards in hand to play
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"` // "dragon", "four_twos", "five_consecutive_pairs", "six_pairs"
+	Hand          []*Card                `protobuf:"bytes,3,rep,name=hand,proto3" json:"hand,omitempty"`       // The winning hand, revealed to everyone
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayCardRequest) Reset() {
-	*x = PlayCardRequest{}
+func (x *InstantWinPacket) Reset() {
+	*x = InstantWinPacket{}
 	mi := &file_game_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayCardRequest) String() string {
+func (x *InstantWinPacket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayCardRequest) ProtoMessage() {}
+func (*InstantWinPacket) ProtoMessage() {}
 
-func (x *PlayCardRequest) ProtoReflect() protoreflect.Message {
+func (x *InstantWinPacket) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -451,41 +653,58 @@ func (x *PlayCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayCardRequest.ProtoReflect.Descriptor instead.
-func (*PlayCardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstantWinPacket.ProtoReflect.Descriptor instead.
+func (*InstantWinPacket) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PlayCardRequest) GetCardIndices() []int32 {
+func (x *InstantWinPacket) GetPlayerId() string {
 	if x != nil {
-		return x.CardIndices
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *InstantWinPacket) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *InstantWinPacket) GetHand() []*Card {
+	if x != nil {
+		return x.Hand
 	}
 	return nil
 }
 
-type TurnUpdatePacket struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ActivePlayerId   string                 `protobuf:"bytes,1,opt,name=active_player_id,json=activePlayerId,proto3" json:"active_player_id,omitempty"`
-	LastPlayedCards  []*Card                `protobuf:"bytes,2,rep,name=last_played_cards,json=lastPlayedCards,proto3" json:"last_played_cards,omitempty"` // Cards currently on table
-	SecondsRemaining int32                  `protobuf:"varint,3,opt,name=seconds_remaining,json=secondsRemaining,proto3" json:"seconds_remaining,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ChopPacket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChopperId     string                 `protobuf:"bytes,1,opt,name=chopper_id,json=chopperId,proto3" json:"chopper_id,omitempty"`
+	VictimId      string                 `protobuf:"bytes,2,opt,name=victim_id,json=victimId,proto3" json:"victim_id,omitempty"`
+	ChoppedCards  []*Card                `protobuf:"bytes,3,rep,name=chopped_cards,json=choppedCards,proto3" json:"chopped_cards,omitempty"` // Cards that were chopped (2s or a bomb)
+	BombCards     []*Card                `protobuf:"bytes,4,rep,name=bomb_cards,json=bombCards,proto3" json:"bomb_cards,omitempty"`          // Cards the chopper played
+	Penalty       int32                  `protobuf:"varint,5,opt,name=penalty,proto3" json:"penalty,omitempty"`                              // Accumulated penalty owed by the victim
+	Chain         int32                  `protobuf:"varint,6,opt,name=chain,proto3" json:"chain,omitempty"`                                  // 1 for a first chop, 2+ for over-chops (chat chong)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TurnUpdatePacket) Reset() {
-	*x = TurnUpdatePacket{}
+func (x *ChopPacket) Reset() {
+	*x = ChopPacket{}
 	mi := &file_game_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TurnUpdatePacket) String() string {
+func (x *ChopPacket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TurnUpdatePacket) ProtoMessage() {}
+func (*ChopPacket) ProtoMessage() {}
 
-func (x *TurnUpdatePacket) ProtoReflect() protoreflect.Message {
+func (x *ChopPacket) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,81 +716,1602 @@ func (x *TurnUpdatePacket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TurnUpdatePacket.ProtoReflect.Descriptor instead.
-func (*TurnUpdatePacket) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChopPacket.ProtoReflect.Descriptor instead.
+func (*ChopPacket) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TurnUpdatePacket) GetActivePlayerId() string {
+func (x *ChopPacket) GetChopperId() string {
 	if x != nil {
-		return x.ActivePlayerId
+		return x.ChopperId
 	}
 	return ""
 }
 
-func (x *TurnUpdatePacket) GetLastPlayedCards() []*Card {
+func (x *ChopPacket) GetVictimId() string {
 	if x != nil {
-		return x.LastPlayedCards
+		return x.VictimId
+	}
+	return ""
+}
+
+func (x *ChopPacket) GetChoppedCards() []*Card {
+	if x != nil {
+		return x.ChoppedCards
 	}
 	return nil
 }
 
-func (x *TurnUpdatePacket) GetSecondsRemaining() int32 {
+func (x *ChopPacket) GetBombCards() []*Card {
 	if x != nil {
-		return x.SecondsRemaining
+		return x.BombCards
+	}
+	return nil
+}
+
+func (x *ChopPacket) GetPenalty() int32 {
+	if x != nil {
+		return x.Penalty
 	}
 	return 0
 }
 
-var File_game_proto protoreflect.FileDescriptor
+func (x *ChopPacket) GetChain() int32 {
+	if x != nil {
+		return x.Chain
+	}
+	return 0
+}
 
-const file_game_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"game.proto\x12\x03api\".\n" +
-	"\x04Card\x12\x12\n" +
-	"\x04suit\x18\x01 \x01(\x05R\x04suit\x12\x12\n" +
-	"\x04rank\x18\x02 \x01(\x05R\x04rank\"1\n" +
-	"\x10HandUpdatePacket\x12\x1d\n" +
-	"\x04hand\x18\x01 \x03(\v2\t.api.CardR\x04hand\"k\n" +
-	"\x10MatchStartPacket\x12\x1d\n" +
-	"\x04hand\x18\x01 \x03(\v2\t.api.CardR\x04hand\x12\x1d\n" +
-	"\n" +
-	"player_ids\x18\x02 \x03(\tR\tplayerIds\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\tR\aownerId\"-\n" +
-	"\x0eGameOverPacket\x12\x1b\n" +
-	"\twinner_id\x18\x01 \x01(\tR\bwinnerId\"-\n" +
-	"\x0eRoundEndPacket\x12\x1b\n" +
-	"\twinner_id\x18\x01 \x01(\tR\bwinnerId\"\xb6\x01\n" +
-	"\x10MatchStatePacket\x12\x1d\n" +
-	"\n" +
-	"is_playing\x18\x01 \x01(\bR\tisPlaying\x12\x19\n" +
-	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1f\n" +
-	"\x05board\x18\x03 \x03(\v2\t.api.CardR\x05board\x12(\n" +
-	"\x10active_player_id\x18\x04 \x01(\tR\x0eactivePlayerId\x12\x1d\n" +
-	"\n" +
-	"player_ids\x18\x05 \x03(\tR\tplayerIds\"4\n" +
-	"\x0fPlayCardRequest\x12!\n" +
-	"\fcard_indices\x18\x01 \x03(\x05R\vcardIndices\"\xa0\x01\n" +
-	"\x10TurnUpdatePacket\x12(\n" +
-	"\x10active_player_id\x18\x01 \x01(\tR\x0eactivePlayerId\x125\n" +
-	"\x11last_played_cards\x18\x02 \x03(\v2\t.api.CardR\x0flastPlayedCards\x12+\n" +
-	"\x11seconds_remaining\x18\x03 \x01(\x05R\x10secondsRemaining*\xe8\x01\n" +
-	"\x06OpCode\x12\x0e\n" +
-	"\n" +
-	"OP_UNKNOWN\x10\x00\x12\x11\n" +
-	"\rOP_GAME_START\x10\x01\x12\x10\n" +
-	"\fOP_PLAY_CARD\x10\x02\x12\x12\n" +
-	"\x0eOP_TURN_UPDATE\x10\x03\x12\f\n" +
-	"\bOP_ERROR\x10\x04\x12\x19\n" +
-	"\x15OP_GAME_START_REQUEST\x10\x05\x12\x13\n" +
-	"\x0fOP_OWNER_UPDATE\x10\x06\x12\x10\n" +
-	"\fOP_GAME_OVER\x10\a\x12\x12\n" +
-	"\x0eOP_MATCH_STATE\x10\b\x12\x12\n" +
-	"\x0eOP_HAND_UPDATE\x10\t\x12\v\n" +
-	"\aOP_PASS\x10\n" +
-	"\x12\x10\n" +
-	"\fOP_ROUND_END\x10\vB\x14Z\x04./pb\xaa\x02\vTienLen.Genb\x06proto3"
+type RoundEndPacket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WinnerId      string                 `protobuf:"bytes,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"` // Player who wins this round
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundEndPacket) Reset() {
+	*x = RoundEndPacket{}
+	mi := &file_game_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundEndPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundEndPacket) ProtoMessage() {}
+
+func (x *RoundEndPacket) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundEndPacket.ProtoReflect.Descriptor instead.
+func (*RoundEndPacket) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RoundEndPacket) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+type MatchStatePacket struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	IsPlaying           bool                   `protobuf:"varint,1,opt,name=is_playing,json=isPlaying,proto3" json:"is_playing,omitempty"`
+	OwnerId             string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Board               []*Card                `protobuf:"bytes,3,rep,name=board,proto3" json:"board,omitempty"`
+	ActivePlayerId      string                 `protobuf:"bytes,4,opt,name=active_player_id,json=activePlayerId,proto3" json:"active_player_id,omitempty"`
+	PlayerIds           []string               `protobuf:"bytes,5,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`                                                                                  // Who is currently playing, by seat; empty string for a free seat
+	Variant             string                 `protobuf:"bytes,6,opt,name=variant,proto3" json:"variant,omitempty"`                                                                                                       // Rule set in use ("southern", "northern")
+	BotIds              []string               `protobuf:"bytes,7,rep,name=bot_ids,json=botIds,proto3" json:"bot_ids,omitempty"`                                                                                           // Seated players controlled by the server
+	NextSeedHash        string                 `protobuf:"bytes,8,opt,name=next_seed_hash,json=nextSeedHash,proto3" json:"next_seed_hash,omitempty"`                                                                       // SHA-256 (hex) of the server seed of the next deal; only entropy sent after it counts
+	PlayerCount         int32                  `protobuf:"varint,9,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`                                                                           // Players dealt in; 2 or 3 is a small table with the opening card rule
+	HandSize            int32                  `protobuf:"varint,10,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"`                                                                                   // Cards dealt to each player
+	OpeningCard         *Card                  `protobuf:"bytes,11,opt,name=opening_card,json=openingCard,proto3" json:"opening_card,omitempty"`                                                                           // Set until the first play, which must include it
+	SeatCount           int32                  `protobuf:"varint,12,opt,name=seat_count,json=seatCount,proto3" json:"seat_count,omitempty"`                                                                                // Table size, 2 to 8; player_ids has one entry per seat
+	TurnDeadlineUnixMs  int64                  `protobuf:"varint,13,opt,name=turn_deadline_unix_ms,json=turnDeadlineUnixMs,proto3" json:"turn_deadline_unix_ms,omitempty"`                                                 // Server time at which the active turn times out; 0 without a turn clock
+	TimeBankMs          map[string]int64       `protobuf:"bytes,14,rep,name=time_bank_ms,json=timeBankMs,proto3" json:"time_bank_ms,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Chess clock rooms: time left per player, in milliseconds
+	DisconnectedIds     []string               `protobuf:"bytes,15,rep,name=disconnected_ids,json=disconnectedIds,proto3" json:"disconnected_ids,omitempty"`                                                               // Seated players who lost their connection; their seat and hand are held
+	Phase               string                 `protobuf:"bytes,16,opt,name=phase,proto3" json:"phase,omitempty"`                                                                                                          // "waiting", "ready_check", "dealing", "playing", "settlement" or "intermission"
+	PhaseDeadlineUnixMs int64                  `protobuf:"varint,17,opt,name=phase_deadline_unix_ms,json=phaseDeadlineUnixMs,proto3" json:"phase_deadline_unix_ms,omitempty"`                                              // Server time at which a timed phase (dealing, settlement) ends; 0 otherwise
+	ReadyIds            []string               `protobuf:"bytes,18,rep,name=ready_ids,json=readyIds,proto3" json:"ready_ids,omitempty"`                                                                                    // Seated players who sent OP_READY for the next game
+	Direction           string                 `protobuf:"bytes,19,opt,name=direction,proto3" json:"direction,omitempty"`                                                                                                  // Way turns travel: "counter_clockwise" goes down the seat numbers, "clockwise" up
+	Config              *RoomConfig            `protobuf:"bytes,20,opt,name=config,proto3" json:"config,omitempty"`                                                                                                        // Settings the room was created with
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MatchStatePacket) Reset() {
+	*x = MatchStatePacket{}
+	mi := &file_game_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchStatePacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchStatePacket) ProtoMessage() {}
+
+func (x *MatchStatePacket) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchStatePacket.ProtoReflect.Descriptor instead.
+func (*MatchStatePacket) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MatchStatePacket) GetIsPlaying() bool {
+	if x != nil {
+		return x.IsPlaying
+	}
+	return false
+}
+
+func (x *MatchStatePacket) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *MatchStatePacket) GetBoard() []*Card {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *MatchStatePacket) GetActivePlayerId() string {
+	if x != nil {
+		return x.ActivePlayerId
+	}
+	return ""
+}
+
+func (x *MatchStatePacket) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *MatchStatePacket) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *MatchStatePacket) GetBotIds() []string {
+	if x != nil {
+		return x.BotIds
+	}
+	return nil
+}
+
+func (x *MatchStatePacket) GetNextSeedHash() string {
+	if x != nil {
+		return x.NextSeedHash
+	}
+	return ""
+}
+
+func (x *MatchStatePacket) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *MatchStatePacket) GetHandSize() int32 {
+	if x != nil {
+		return x.HandSize
+	}
+	return 0
+}
+
+func (x *MatchStatePacket) GetOpeningCard() *Card {
+	if x != nil {
+		return x.OpeningCard
+	}
+	return nil
+}
+
+func (x *MatchStatePacket) GetSeatCount() int32 {
+	if x != nil {
+		return x.SeatCount
+	}
+	return 0
+}
+
+func (x *MatchStatePacket) GetTurnDeadlineUnixMs() int64 {
+	if x != nil {
+		return x.TurnDeadlineUnixMs
+	}
+	return 0
+}
+
+func (x *MatchStatePacket) GetTimeBankMs() map[string]int64 {
+	if x != nil {
+		return x.TimeBankMs
+	}
+	return nil
+}
+
+func (x *MatchStatePacket) GetDisconnectedIds() []string {
+	if x != nil {
+		return x.DisconnectedIds
+	}
+	return nil
+}
+
+func (x *MatchStatePacket) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *MatchStatePacket) GetPhaseDeadlineUnixMs() int64 {
+	if x != nil {
+		return x.PhaseDeadlineUnixMs
+	}
+	return 0
+}
+
+func (x *MatchStatePacket) GetReadyIds() []string {
+	if x != nil {
+		return x.ReadyIds
+	}
+	return nil
+}
+
+func (x *MatchStatePacket) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *MatchStatePacket) GetConfig() *RoomConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// Room settings chosen when the match is created; the create_match RPC payload is the
+// JSON form of this message, except that it gives the "password" itself.
+type RoomConfig struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Variant                string                 `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`                    // "southern" or "northern"
+	Seats                  int32                  `protobuf:"varint,2,opt,name=seats,proto3" json:"seats,omitempty"`                       // 2 to 8
+	HandSize               int32                  `protobuf:"varint,3,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"` // 0 means 13
+	DealAll                bool                   `protobuf:"varint,4,opt,name=deal_all,json=dealAll,proto3" json:"deal_all,omitempty"`
+	Direction              string                 `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`                                       // "counter_clockwise" or "clockwise"
+	TurnSeconds            int32                  `protobuf:"varint,6,opt,name=turn_seconds,json=turnSeconds,proto3" json:"turn_seconds,omitempty"`               // 0 plays without a turn clock
+	TimeBankSeconds        int32                  `protobuf:"varint,7,opt,name=time_bank_seconds,json=timeBankSeconds,proto3" json:"time_bank_seconds,omitempty"` // 0 plays without a time bank
+	IncrementSeconds       int32                  `protobuf:"varint,8,opt,name=increment_seconds,json=incrementSeconds,proto3" json:"increment_seconds,omitempty"`
+	DisconnectGraceSeconds int32                  `protobuf:"varint,9,opt,name=disconnect_grace_seconds,json=disconnectGraceSeconds,proto3" json:"disconnect_grace_seconds,omitempty"`
+	Stake                  int64                  `protobuf:"varint,10,opt,name=stake,proto3" json:"stake,omitempty"`     // Chips per settlement point; 0 plays for fun
+	Private                bool                   `protobuf:"varint,11,opt,name=private,proto3" json:"private,omitempty"` // Kept out of quick match
+	HintsAllowed           bool                   `protobuf:"varint,12,opt,name=hints_allowed,json=hintsAllowed,proto3" json:"hints_allowed,omitempty"`
+	MaxSpectators          int32                  `protobuf:"varint,13,opt,name=max_spectators,json=maxSpectators,proto3" json:"max_spectators,omitempty"`
+	AutoRestart            bool                   `protobuf:"varint,14,opt,name=auto_restart,json=autoRestart,proto3" json:"auto_restart,omitempty"` // Deal every game after the first without a ready check
+	HasPassword            bool                   `protobuf:"varint,15,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"` // Joining needs the "password" join metadata
+	JoinCode               string                 `protobuf:"bytes,16,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`           // Private rooms: the code to pass to join_by_code
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RoomConfig) Reset() {
+	*x = RoomConfig{}
+	mi := &file_game_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomConfig) ProtoMessage() {}
+
+func (x *RoomConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomConfig.ProtoReflect.Descriptor instead.
+func (*RoomConfig) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RoomConfig) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *RoomConfig) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *RoomConfig) GetHandSize() int32 {
+	if x != nil {
+		return x.HandSize
+	}
+	return 0
+}
+
+func (x *RoomConfig) GetDealAll() bool {
+	if x != nil {
+		return x.DealAll
+	}
+	return false
+}
+
+func (x *RoomConfig) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *RoomConfig) GetTurnSeconds() int32 {
+	if x != nil {
+		return x.TurnSeconds
+	}
+	return 0
+}
+
+func (x *RoomConfig) GetTimeBankSeconds() int32 {
+	if x != nil {
+		return x.TimeBankSeconds
+	}
+	return 0
+}
+
+func (x *RoomConfig) GetIncrementSeconds() int32 {
+	if x != nil {
+		return x.IncrementSeconds
+	}
+	return 0
+}
+
+func (x *RoomConfig) GetDisconnectGraceSeconds() int32 {
+	if x != nil {
+		return x.DisconnectGraceSeconds
+	}
+	return 0
+}
+
+func (x *RoomConfig) GetStake() int64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *RoomConfig) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *RoomConfig) GetHintsAllowed() bool {
+	if x != nil {
+		return x.HintsAllowed
+	}
+	return false
+}
+
+func (x *RoomConfig) GetMaxSpectators() int32 {
+	if x != nil {
+		return x.MaxSpectators
+	}
+	return 0
+}
+
+func (x *RoomConfig) GetAutoRestart() bool {
+	if x != nil {
+		return x.AutoRestart
+	}
+	return false
+}
+
+func (x *RoomConfig) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *RoomConfig) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+type ReadyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entropy       string                 `protobuf:"bytes,1,opt,name=entropy,proto3" json:"entropy,omitempty"` // Optional: mixed into the next deal, whose seed hash is already published
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadyRequest) Reset() {
+	*x = ReadyRequest{}
+	mi := &file_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyRequest) ProtoMessage() {}
+
+func (x *ReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyRequest.ProtoReflect.Descriptor instead.
+func (*ReadyRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReadyRequest) GetEntropy() string {
+	if x != nil {
+		return x.Entropy
+	}
+	return ""
+}
+
+type AddBotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Strategy      string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"` // "greedy" (default) or "random"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBotRequest) Reset() {
+	*x = AddBotRequest{}
+	mi := &file_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotRequest) ProtoMessage() {}
+
+func (x *AddBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotRequest.ProtoReflect.Descriptor instead.
+func (*AddBotRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddBotRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+type RemoveBotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBotRequest) Reset() {
+	*x = RemoveBotRequest{}
+	mi := &file_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBotRequest) ProtoMessage() {}
+
+func (x *RemoveBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBotRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBotRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveBotRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+type PlayCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardIndices   []int32                `protobuf:"varint,1,rep,packed,name=card_indices,json=cardIndices,proto3" json:"card_indices,omitempty"` // Indices of cards in hand to play (legacy; prefer cards)
+	Cards         []*Card                `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`                                        // Cards to play by value; takes precedence over card_indices
+	HandVersion   int32                  `protobuf:"varint,3,opt,name=hand_version,json=handVersion,proto3" json:"hand_version,omitempty"`        // Optional: hand version the indices refer to; stale versions are rejected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayCardRequest) Reset() {
+	*x = PlayCardRequest{}
+	mi := &file_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayCardRequest) ProtoMessage() {}
+
+func (x *PlayCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayCardRequest.ProtoReflect.Descriptor instead.
+func (*PlayCardRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlayCardRequest) GetCardIndices() []int32 {
+	if x != nil {
+		return x.CardIndices
+	}
+	return nil
+}
+
+func (x *PlayCardRequest) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *PlayCardRequest) GetHandVersion() int32 {
+	if x != nil {
+		return x.HandVersion
+	}
+	return 0
+}
+
+type HintMove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardIndices   []int32                `protobuf:"varint,1,rep,packed,name=card_indices,json=cardIndices,proto3" json:"card_indices,omitempty"` // Indices into the player's current hand
+	Cards         []*Card                `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HintMove) Reset() {
+	*x = HintMove{}
+	mi := &file_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HintMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HintMove) ProtoMessage() {}
+
+func (x *HintMove) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HintMove.ProtoReflect.Descriptor instead.
+func (*HintMove) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HintMove) GetCardIndices() []int32 {
+	if x != nil {
+		return x.CardIndices
+	}
+	return nil
+}
+
+func (x *HintMove) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type HintPacket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moves         []*HintMove            `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`                     // Suggested plays, best first
+	CanPass       bool                   `protobuf:"varint,2,opt,name=can_pass,json=canPass,proto3" json:"can_pass,omitempty"` // Passing is also allowed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HintPacket) Reset() {
+	*x = HintPacket{}
+	mi := &file_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HintPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HintPacket) ProtoMessage() {}
+
+func (x *HintPacket) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HintPacket.ProtoReflect.Descriptor instead.
+func (*HintPacket) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HintPacket) GetMoves() []*HintMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *HintPacket) GetCanPass() bool {
+	if x != nil {
+		return x.CanPass
+	}
+	return false
+}
+
+type TurnUpdatePacket struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ActivePlayerId   string                 `protobuf:"bytes,1,opt,name=active_player_id,json=activePlayerId,proto3" json:"active_player_id,omitempty"`
+	LastPlayedCards  []*Card                `protobuf:"bytes,2,rep,name=last_played_cards,json=lastPlayedCards,proto3" json:"last_played_cards,omitempty"`                                                             // Cards currently on table
+	SecondsRemaining int32                  `protobuf:"varint,3,opt,name=seconds_remaining,json=secondsRemaining,proto3" json:"seconds_remaining,omitempty"`                                                           // Time left on the turn clock; 0 when the room has none
+	DeadlineUnixMs   int64                  `protobuf:"varint,4,opt,name=deadline_unix_ms,json=deadlineUnixMs,proto3" json:"deadline_unix_ms,omitempty"`                                                               // Server time at which the turn times out; 0 when the room has none
+	TimeBankMs       map[string]int64       `protobuf:"bytes,5,rep,name=time_bank_ms,json=timeBankMs,proto3" json:"time_bank_ms,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Chess clock rooms: time left per player, in milliseconds
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TurnUpdatePacket) Reset() {
+	*x = TurnUpdatePacket{}
+	mi := &file_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnUpdatePacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnUpdatePacket) ProtoMessage() {}
+
+func (x *TurnUpdatePacket) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnUpdatePacket.ProtoReflect.Descriptor instead.
+func (*TurnUpdatePacket) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TurnUpdatePacket) GetActivePlayerId() string {
+	if x != nil {
+		return x.ActivePlayerId
+	}
+	return ""
+}
+
+func (x *TurnUpdatePacket) GetLastPlayedCards() []*Card {
+	if x != nil {
+		return x.LastPlayedCards
+	}
+	return nil
+}
+
+func (x *TurnUpdatePacket) GetSecondsRemaining() int32 {
+	if x != nil {
+		return x.SecondsRemaining
+	}
+	return 0
+}
+
+func (x *TurnUpdatePacket) GetDeadlineUnixMs() int64 {
+	if x != nil {
+		return x.DeadlineUnixMs
+	}
+	return 0
+}
+
+func (x *TurnUpdatePacket) GetTimeBankMs() map[string]int64 {
+	if x != nil {
+		return x.TimeBankMs
+	}
+	return nil
+}
+
+type TurnTimedOutPacket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Cards         []*Card                `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"` // The lowest single, played when the player led the round; empty for a pass
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurnTimedOutPacket) Reset() {
+	*x = TurnTimedOutPacket{}
+	mi := &file_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnTimedOutPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnTimedOutPacket) ProtoMessage() {}
+
+func (x *TurnTimedOutPacket) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnTimedOutPacket.ProtoReflect.Descriptor instead.
+func (*TurnTimedOutPacket) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TurnTimedOutPacket) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *TurnTimedOutPacket) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// Complete engine state, for persistence and moving a table between nodes.
+// It holds every hand and the secret deal seed, so it is never sent to players.
+type GameState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Version           int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Variant           string                 `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	Seed              int64                  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	IsPlaying         bool                   `protobuf:"varint,4,opt,name=is_playing,json=isPlaying,proto3" json:"is_playing,omitempty"`
+	OwnerId           string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Players           []string               `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"` // In the order given to Start
+	TurnOrder         []string               `protobuf:"bytes,7,rep,name=turn_order,json=turnOrder,proto3" json:"turn_order,omitempty"`
+	CurrentIdx        int32                  `protobuf:"varint,8,opt,name=current_idx,json=currentIdx,proto3" json:"current_idx,omitempty"`
+	Hands             map[string]*CardList   `protobuf:"bytes,9,rep,name=hands,proto3" json:"hands,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HandVersions      map[string]int32       `protobuf:"bytes,10,rep,name=hand_versions,json=handVersions,proto3" json:"hand_versions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Deck              []*Card                `protobuf:"bytes,11,rep,name=deck,proto3" json:"deck,omitempty"`
+	Commitment        *DealReveal            `protobuf:"bytes,12,opt,name=commitment,proto3" json:"commitment,omitempty"` // Set for committed deals; players and deck are left empty
+	Board             []*Card                `protobuf:"bytes,13,rep,name=board,proto3" json:"board,omitempty"`
+	LastActor         string                 `protobuf:"bytes,14,opt,name=last_actor,json=lastActor,proto3" json:"last_actor,omitempty"`
+	RoundSkippers     []string               `protobuf:"bytes,15,rep,name=round_skippers,json=roundSkippers,proto3" json:"round_skippers,omitempty"`
+	ChopChainOpen     bool                   `protobuf:"varint,16,opt,name=chop_chain_open,json=chopChainOpen,proto3" json:"chop_chain_open,omitempty"`
+	Winners           []string               `protobuf:"bytes,17,rep,name=winners,proto3" json:"winners,omitempty"`
+	FinishedPlayers   []string               `protobuf:"bytes,18,rep,name=finished_players,json=finishedPlayers,proto3" json:"finished_players,omitempty"`
+	Chops             []*ChopPacket          `protobuf:"bytes,19,rep,name=chops,proto3" json:"chops,omitempty"`
+	CardsPlayed       map[string]int32       `protobuf:"bytes,20,rep,name=cards_played,json=cardsPlayed,proto3" json:"cards_played,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	EndedByInstantWin bool                   `protobuf:"varint,21,opt,name=ended_by_instant_win,json=endedByInstantWin,proto3" json:"ended_by_instant_win,omitempty"`
+	Log               []*LogEntry            `protobuf:"bytes,22,rep,name=log,proto3" json:"log,omitempty"`
+	Deal              *DealConfig            `protobuf:"bytes,23,opt,name=deal,proto3" json:"deal,omitempty"`
+	HandSize          int32                  `protobuf:"varint,24,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"`
+	OpeningCard       *Card                  `protobuf:"bytes,25,opt,name=opening_card,json=openingCard,proto3" json:"opening_card,omitempty"`
+	Abandoned         []string               `protobuf:"bytes,26,rep,name=abandoned,proto3" json:"abandoned,omitempty"` // Players who left mid-game; bots play their hands
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GameState) Reset() {
+	*x = GameState{}
+	mi := &file_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState) ProtoMessage() {}
+
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GameState) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GameState) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *GameState) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GameState) GetIsPlaying() bool {
+	if x != nil {
+		return x.IsPlaying
+	}
+	return false
+}
+
+func (x *GameState) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GameState) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameState) GetTurnOrder() []string {
+	if x != nil {
+		return x.TurnOrder
+	}
+	return nil
+}
+
+func (x *GameState) GetCurrentIdx() int32 {
+	if x != nil {
+		return x.CurrentIdx
+	}
+	return 0
+}
+
+func (x *GameState) GetHands() map[string]*CardList {
+	if x != nil {
+		return x.Hands
+	}
+	return nil
+}
+
+func (x *GameState) GetHandVersions() map[string]int32 {
+	if x != nil {
+		return x.HandVersions
+	}
+	return nil
+}
+
+func (x *GameState) GetDeck() []*Card {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+func (x *GameState) GetCommitment() *DealReveal {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *GameState) GetBoard() []*Card {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *GameState) GetLastActor() string {
+	if x != nil {
+		return x.LastActor
+	}
+	return ""
+}
+
+func (x *GameState) GetRoundSkippers() []string {
+	if x != nil {
+		return x.RoundSkippers
+	}
+	return nil
+}
+
+func (x *GameState) GetChopChainOpen() bool {
+	if x != nil {
+		return x.ChopChainOpen
+	}
+	return false
+}
+
+func (x *GameState) GetWinners() []string {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+func (x *GameState) GetFinishedPlayers() []string {
+	if x != nil {
+		return x.FinishedPlayers
+	}
+	return nil
+}
+
+func (x *GameState) GetChops() []*ChopPacket {
+	if x != nil {
+		return x.Chops
+	}
+	return nil
+}
+
+func (x *GameState) GetCardsPlayed() map[string]int32 {
+	if x != nil {
+		return x.CardsPlayed
+	}
+	return nil
+}
+
+func (x *GameState) GetEndedByInstantWin() bool {
+	if x != nil {
+		return x.EndedByInstantWin
+	}
+	return false
+}
+
+func (x *GameState) GetLog() []*LogEntry {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *GameState) GetDeal() *DealConfig {
+	if x != nil {
+		return x.Deal
+	}
+	return nil
+}
+
+func (x *GameState) GetHandSize() int32 {
+	if x != nil {
+		return x.HandSize
+	}
+	return 0
+}
+
+func (x *GameState) GetOpeningCard() *Card {
+	if x != nil {
+		return x.OpeningCard
+	}
+	return nil
+}
+
+func (x *GameState) GetAbandoned() []string {
+	if x != nil {
+		return x.Abandoned
+	}
+	return nil
+}
+
+// How the deck is dealt; see MatchStatePacket.player_count for small tables.
+type DealConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HandSize      int32                  `protobuf:"varint,1,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"` // 0 means 13
+	DealAll       bool                   `protobuf:"varint,2,opt,name=deal_all,json=dealAll,proto3" json:"deal_all,omitempty"`    // Share out the whole deck
+	Direction     string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`                // "counter_clockwise" (default) or "clockwise"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DealConfig) Reset() {
+	*x = DealConfig{}
+	mi := &file_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DealConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealConfig) ProtoMessage() {}
+
+func (x *DealConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealConfig.ProtoReflect.Descriptor instead.
+func (*DealConfig) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DealConfig) GetHandSize() int32 {
+	if x != nil {
+		return x.HandSize
+	}
+	return 0
+}
+
+func (x *DealConfig) GetDealAll() bool {
+	if x != nil {
+		return x.DealAll
+	}
+	return false
+}
+
+func (x *DealConfig) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+// One accepted command of a game's log; replaying the log rebuilds the game.
+type LogEntry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Seq      int32                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Kind     string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // "start", "play", "pass", "timeout" or "leave"
+	PlayerId string                 `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Cards    []*Card                `protobuf:"bytes,4,rep,name=cards,proto3" json:"cards,omitempty"` // Cards played, by value
+	// Start parameters.
+	Variant       string      `protobuf:"bytes,5,opt,name=variant,proto3" json:"variant,omitempty"`
+	Seed          int64       `protobuf:"varint,6,opt,name=seed,proto3" json:"seed,omitempty"`
+	PlayerIds     []string    `protobuf:"bytes,7,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	OwnerId       string      `protobuf:"bytes,8,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	LastWinnerId  string      `protobuf:"bytes,9,opt,name=last_winner_id,json=lastWinnerId,proto3" json:"last_winner_id,omitempty"`
+	Commitment    *DealReveal `protobuf:"bytes,10,opt,name=commitment,proto3" json:"commitment,omitempty"` // Set for committed deals; players and deck are left empty
+	Deal          *DealConfig `protobuf:"bytes,11,opt,name=deal,proto3" json:"deal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	mi := &file_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LogEntry) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *LogEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LogEntry) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *LogEntry) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *LogEntry) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *LogEntry) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *LogEntry) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *LogEntry) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *LogEntry) GetLastWinnerId() string {
+	if x != nil {
+		return x.LastWinnerId
+	}
+	return ""
+}
+
+func (x *LogEntry) GetCommitment() *DealReveal {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *LogEntry) GetDeal() *DealConfig {
+	if x != nil {
+		return x.Deal
+	}
+	return nil
+}
+
+type CardList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardList) Reset() {
+	*x = CardList{}
+	mi := &file_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardList) ProtoMessage() {}
+
+func (x *CardList) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardList.ProtoReflect.Descriptor instead.
+func (*CardList) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CardList) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+var File_game_proto protoreflect.FileDescriptor
+
+const file_game_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"game.proto\x12\x03api\".\n" +
+	"\x04Card\x12\x12\n" +
+	"\x04suit\x18\x01 \x01(\x05R\x04suit\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x05R\x04rank\"K\n" +
+	"\x10HandUpdatePacket\x12\x1d\n" +
+	"\x04hand\x18\x01 \x03(\v2\t.api.CardR\x04hand\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\xc8\x02\n" +
+	"\x10MatchStartPacket\x12\x1d\n" +
+	"\x04hand\x18\x01 \x03(\v2\t.api.CardR\x04hand\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\x02 \x03(\tR\tplayerIds\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12'\n" +
+	"\x0fdeal_commitment\x18\x04 \x01(\tR\x0edealCommitment\x12%\n" +
+	"\x0eclient_entropy\x18\x05 \x01(\tR\rclientEntropy\x12!\n" +
+	"\fhand_version\x18\x06 \x01(\x05R\vhandVersion\x12\x1b\n" +
+	"\thand_size\x18\a \x01(\x05R\bhandSize\x12,\n" +
+	"\fopening_card\x18\b \x01(\v2\t.api.CardR\vopeningCard\x12\x1d\n" +
+	"\n" +
+	"turn_order\x18\t \x03(\tR\tturnOrder\"\x9d\x01\n" +
+	"\x0eGameOverPacket\x12\x1b\n" +
+	"\twinner_id\x18\x01 \x01(\tR\bwinnerId\x12\x1c\n" +
+	"\tstandings\x18\x02 \x03(\tR\tstandings\x12+\n" +
+	"\aresults\x18\x03 \x03(\v2\x11.api.PlayerResultR\aresults\x12#\n" +
+	"\x04deal\x18\x04 \x01(\v2\x0f.api.DealRevealR\x04deal\"\x8a\x02\n" +
+	"\n" +
+	"DealReveal\x12\x1f\n" +
+	"\vserver_seed\x18\x01 \x01(\tR\n" +
+	"serverSeed\x12%\n" +
+	"\x0eclient_entropy\x18\x02 \x01(\tR\rclientEntropy\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x03 \x01(\tR\n" +
+	"commitment\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\x04 \x03(\tR\tplayerIds\x12\x1d\n" +
+	"\x04deck\x18\x05 \x03(\v2\t.api.CardR\x04deck\x12\x1b\n" +
+	"\tseed_hash\x18\x06 \x01(\tR\bseedHash\x12\x1b\n" +
+	"\thand_size\x18\a \x01(\x05R\bhandSize\x12\x1c\n" +
+	"\tdirection\x18\b \x01(\tR\tdirection\"\xd1\x02\n" +
+	"\fPlayerResult\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x14\n" +
+	"\x05place\x18\x02 \x01(\x05R\x05place\x12\x12\n" +
+	"\x04cong\x18\x03 \x01(\bR\x04cong\x120\n" +
+	"\x0eremaining_hand\x18\x04 \x03(\v2\t.api.CardR\rremainingHand\x12)\n" +
+	"\x10placement_points\x18\x05 \x01(\x05R\x0fplacementPoints\x12\x1f\n" +
+	"\vcong_points\x18\x06 \x01(\x05R\n" +
+	"congPoints\x12'\n" +
+	"\x0fleftover_points\x18\a \x01(\x05R\x0eleftoverPoints\x12\x1f\n" +
+	"\vchop_points\x18\b \x01(\x05R\n" +
+	"chopPoints\x12\x14\n" +
+	"\x05total\x18\t \x01(\x05R\x05total\x12\x1c\n" +
+	"\tabandoned\x18\n" +
+	" \x01(\bR\tabandoned\"h\n" +
+	"\x10InstantWinPacket\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x1d\n" +
+	"\x04hand\x18\x03 \x03(\v2\t.api.CardR\x04hand\"\xd2\x01\n" +
+	"\n" +
+	"ChopPacket\x12\x1d\n" +
+	"\n" +
+	"chopper_id\x18\x01 \x01(\tR\tchopperId\x12\x1b\n" +
+	"\tvictim_id\x18\x02 \x01(\tR\bvictimId\x12.\n" +
+	"\rchopped_cards\x18\x03 \x03(\v2\t.api.CardR\fchoppedCards\x12(\n" +
+	"\n" +
+	"bomb_cards\x18\x04 \x03(\v2\t.api.CardR\tbombCards\x12\x18\n" +
+	"\apenalty\x18\x05 \x01(\x05R\apenalty\x12\x14\n" +
+	"\x05chain\x18\x06 \x01(\x05R\x05chain\"-\n" +
+	"\x0eRoundEndPacket\x12\x1b\n" +
+	"\twinner_id\x18\x01 \x01(\tR\bwinnerId\"\xb1\x06\n" +
+	"\x10MatchStatePacket\x12\x1d\n" +
+	"\n" +
+	"is_playing\x18\x01 \x01(\bR\tisPlaying\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1f\n" +
+	"\x05board\x18\x03 \x03(\v2\t.api.CardR\x05board\x12(\n" +
+	"\x10active_player_id\x18\x04 \x01(\tR\x0eactivePlayerId\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\x05 \x03(\tR\tplayerIds\x12\x18\n" +
+	"\avariant\x18\x06 \x01(\tR\avariant\x12\x17\n" +
+	"\abot_ids\x18\a \x03(\tR\x06botIds\x12$\n" +
+	"\x0enext_seed_hash\x18\b \x01(\tR\fnextSeedHash\x12!\n" +
+	"\fplayer_count\x18\t \x01(\x05R\vplayerCount\x12\x1b\n" +
+	"\thand_size\x18\n" +
+	" \x01(\x05R\bhandSize\x12,\n" +
+	"\fopening_card\x18\v \x01(\v2\t.api.CardR\vopeningCard\x12\x1d\n" +
+	"\n" +
+	"seat_count\x18\f \x01(\x05R\tseatCount\x121\n" +
+	"\x15turn_deadline_unix_ms\x18\r \x01(\x03R\x12turnDeadlineUnixMs\x12G\n" +
+	"\ftime_bank_ms\x18\x0e \x03(\v2%.api.MatchStatePacket.TimeBankMsEntryR\n" +
+	"timeBankMs\x12)\n" +
+	"\x10disconnected_ids\x18\x0f \x03(\tR\x0fdisconnectedIds\x12\x14\n" +
+	"\x05phase\x18\x10 \x01(\tR\x05phase\x123\n" +
+	"\x16phase_deadline_unix_ms\x18\x11 \x01(\x03R\x13phaseDeadlineUnixMs\x12\x1b\n" +
+	"\tready_ids\x18\x12 \x03(\tR\breadyIds\x12\x1c\n" +
+	"\tdirection\x18\x13 \x01(\tR\tdirection\x12'\n" +
+	"\x06config\x18\x14 \x01(\v2\x0f.api.RoomConfigR\x06config\x1a=\n" +
+	"\x0fTimeBankMsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xa7\x04\n" +
+	"\n" +
+	"RoomConfig\x12\x18\n" +
+	"\avariant\x18\x01 \x01(\tR\avariant\x12\x14\n" +
+	"\x05seats\x18\x02 \x01(\x05R\x05seats\x12\x1b\n" +
+	"\thand_size\x18\x03 \x01(\x05R\bhandSize\x12\x19\n" +
+	"\bdeal_all\x18\x04 \x01(\bR\adealAll\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\x12!\n" +
+	"\fturn_seconds\x18\x06 \x01(\x05R\vturnSeconds\x12*\n" +
+	"\x11time_bank_seconds\x18\a \x01(\x05R\x0ftimeBankSeconds\x12+\n" +
+	"\x11increment_seconds\x18\b \x01(\x05R\x10incrementSeconds\x128\n" +
+	"\x18disconnect_grace_seconds\x18\t \x01(\x05R\x16disconnectGraceSeconds\x12\x14\n" +
+	"\x05stake\x18\n" +
+	" \x01(\x03R\x05stake\x12\x18\n" +
+	"\aprivate\x18\v \x01(\bR\aprivate\x12#\n" +
+	"\rhints_allowed\x18\f \x01(\bR\fhintsAllowed\x12%\n" +
+	"\x0emax_spectators\x18\r \x01(\x05R\rmaxSpectators\x12!\n" +
+	"\fauto_restart\x18\x0e \x01(\bR\vautoRestart\x12!\n" +
+	"\fhas_password\x18\x0f \x01(\bR\vhasPassword\x12\x1b\n" +
+	"\tjoin_code\x18\x10 \x01(\tR\bjoinCode\"(\n" +
+	"\fReadyRequest\x12\x18\n" +
+	"\aentropy\x18\x01 \x01(\tR\aentropy\"+\n" +
+	"\rAddBotRequest\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\")\n" +
+	"\x10RemoveBotRequest\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\"x\n" +
+	"\x0fPlayCardRequest\x12!\n" +
+	"\fcard_indices\x18\x01 \x03(\x05R\vcardIndices\x12\x1f\n" +
+	"\x05cards\x18\x02 \x03(\v2\t.api.CardR\x05cards\x12!\n" +
+	"\fhand_version\x18\x03 \x01(\x05R\vhandVersion\"N\n" +
+	"\bHintMove\x12!\n" +
+	"\fcard_indices\x18\x01 \x03(\x05R\vcardIndices\x12\x1f\n" +
+	"\x05cards\x18\x02 \x03(\v2\t.api.CardR\x05cards\"L\n" +
+	"\n" +
+	"HintPacket\x12#\n" +
+	"\x05moves\x18\x01 \x03(\v2\r.api.HintMoveR\x05moves\x12\x19\n" +
+	"\bcan_pass\x18\x02 \x01(\bR\acanPass\"\xd2\x02\n" +
+	"\x10TurnUpdatePacket\x12(\n" +
+	"\x10active_player_id\x18\x01 \x01(\tR\x0eactivePlayerId\x125\n" +
+	"\x11last_played_cards\x18\x02 \x03(\v2\t.api.CardR\x0flastPlayedCards\x12+\n" +
+	"\x11seconds_remaining\x18\x03 \x01(\x05R\x10secondsRemaining\x12(\n" +
+	"\x10deadline_unix_ms\x18\x04 \x01(\x03R\x0edeadlineUnixMs\x12G\n" +
+	"\ftime_bank_ms\x18\x05 \x03(\v2%.api.TurnUpdatePacket.TimeBankMsEntryR\n" +
+	"timeBankMs\x1a=\n" +
+	"\x0fTimeBankMsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"R\n" +
+	"\x12TurnTimedOutPacket\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\x05cards\x18\x02 \x03(\v2\t.api.CardR\x05cards\"\x98\t\n" +
+	"\tGameState\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x18\n" +
+	"\avariant\x18\x02 \x01(\tR\avariant\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x03R\x04seed\x12\x1d\n" +
+	"\n" +
+	"is_playing\x18\x04 \x01(\bR\tisPlaying\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\tR\aownerId\x12\x18\n" +
+	"\aplayers\x18\x06 \x03(\tR\aplayers\x12\x1d\n" +
+	"\n" +
+	"turn_order\x18\a \x03(\tR\tturnOrder\x12\x1f\n" +
+	"\vcurrent_idx\x18\b \x01(\x05R\n" +
+	"currentIdx\x12/\n" +
+	"\x05hands\x18\t \x03(\v2\x19.api.GameState.HandsEntryR\x05hands\x12E\n" +
+	"\rhand_versions\x18\n" +
+	" \x03(\v2 .api.GameState.HandVersionsEntryR\fhandVersions\x12\x1d\n" +
+	"\x04deck\x18\v \x03(\v2\t.api.CardR\x04deck\x12/\n" +
+	"\n" +
+	"commitment\x18\f \x01(\v2\x0f.api.DealRevealR\n" +
+	"commitment\x12\x1f\n" +
+	"\x05board\x18\r \x03(\v2\t.api.CardR\x05board\x12\x1d\n" +
+	"\n" +
+	"last_actor\x18\x0e \x01(\tR\tlastActor\x12%\n" +
+	"\x0eround_skippers\x18\x0f \x03(\tR\rroundSkippers\x12&\n" +
+	"\x0fchop_chain_open\x18\x10 \x01(\bR\rchopChainOpen\x12\x18\n" +
+	"\awinners\x18\x11 \x03(\tR\awinners\x12)\n" +
+	"\x10finished_players\x18\x12 \x03(\tR\x0ffinishedPlayers\x12%\n" +
+	"\x05chops\x18\x13 \x03(\v2\x0f.api.ChopPacketR\x05chops\x12B\n" +
+	"\fcards_played\x18\x14 \x03(\v2\x1f.api.GameState.CardsPlayedEntryR\vcardsPlayed\x12/\n" +
+	"\x14ended_by_instant_win\x18\x15 \x01(\bR\x11endedByInstantWin\x12\x1f\n" +
+	"\x03log\x18\x16 \x03(\v2\r.api.LogEntryR\x03log\x12#\n" +
+	"\x04deal\x18\x17 \x01(\v2\x0f.api.DealConfigR\x04deal\x12\x1b\n" +
+	"\thand_size\x18\x18 \x01(\x05R\bhandSize\x12,\n" +
+	"\fopening_card\x18\x19 \x01(\v2\t.api.CardR\vopeningCard\x12\x1c\n" +
+	"\tabandoned\x18\x1a \x03(\tR\tabandoned\x1aG\n" +
+	"\n" +
+	"HandsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12#\n" +
+	"\x05value\x18\x02 \x01(\v2\r.api.CardListR\x05value:\x028\x01\x1a?\n" +
+	"\x11HandVersionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a>\n" +
+	"\x10CardsPlayedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"b\n" +
+	"\n" +
+	"DealConfig\x12\x1b\n" +
+	"\thand_size\x18\x01 \x01(\x05R\bhandSize\x12\x19\n" +
+	"\bdeal_all\x18\x02 \x01(\bR\adealAll\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\"\xd2\x02\n" +
+	"\bLogEntry\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x05R\x03seq\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\x05cards\x18\x04 \x03(\v2\t.api.CardR\x05cards\x12\x18\n" +
+	"\avariant\x18\x05 \x01(\tR\avariant\x12\x12\n" +
+	"\x04seed\x18\x06 \x01(\x03R\x04seed\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\a \x03(\tR\tplayerIds\x12\x19\n" +
+	"\bowner_id\x18\b \x01(\tR\aownerId\x12$\n" +
+	"\x0elast_winner_id\x18\t \x01(\tR\flastWinnerId\x12/\n" +
+	"\n" +
+	"commitment\x18\n" +
+	" \x01(\v2\x0f.api.DealRevealR\n" +
+	"commitment\x12#\n" +
+	"\x04deal\x18\v \x01(\v2\x0f.api.DealConfigR\x04deal\"+\n" +
+	"\bCardList\x12\x1f\n" +
+	"\x05cards\x18\x01 \x03(\v2\t.api.CardR\x05cards*\xf3\x02\n" +
+	"\x06OpCode\x12\x0e\n" +
+	"\n" +
+	"OP_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rOP_GAME_START\x10\x01\x12\x10\n" +
+	"\fOP_PLAY_CARD\x10\x02\x12\x12\n" +
+	"\x0eOP_TURN_UPDATE\x10\x03\x12\f\n" +
+	"\bOP_ERROR\x10\x04\x12\x19\n" +
+	"\x15OP_GAME_START_REQUEST\x10\x05\x12\x13\n" +
+	"\x0fOP_OWNER_UPDATE\x10\x06\x12\x10\n" +
+	"\fOP_GAME_OVER\x10\a\x12\x12\n" +
+	"\x0eOP_MATCH_STATE\x10\b\x12\x12\n" +
+	"\x0eOP_HAND_UPDATE\x10\t\x12\v\n" +
+	"\aOP_PASS\x10\n" +
+	"\x12\x10\n" +
+	"\fOP_ROUND_END\x10\v\x12\x12\n" +
+	"\x0eOP_INSTANT_WIN\x10\f\x12\v\n" +
+	"\aOP_CHOP\x10\r\x12\x13\n" +
+	"\x0fOP_HINT_REQUEST\x10\x0e\x12\v\n" +
+	"\aOP_HINT\x10\x0f\x12\x0e\n" +
+	"\n" +
+	"OP_ADD_BOT\x10\x10\x12\x11\n" +
+	"\rOP_REMOVE_BOT\x10\x11\x12\x15\n" +
+	"\x11OP_TURN_TIMED_OUT\x10\x12\x12\f\n" +
+	"\bOP_READY\x10\x13B\x14Z\x04./pb\xaa\x02\vTienLen.Genb\x06proto3"
 
 var (
 	file_game_proto_rawDescOnce sync.Once
@@ -586,28 +2326,79 @@ func file_game_proto_rawDescGZIP() []byte {
 }
 
 var file_game_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_game_proto_goTypes = []any{
-	(OpCode)(0),              // 0: api.OpCode
-	(*Card)(nil),             // 1: api.Card
-	(*HandUpdatePacket)(nil), // 2: api.HandUpdatePacket
-	(*MatchStartPacket)(nil), // 3: api.MatchStartPacket
-	(*GameOverPacket)(nil),   // 4: api.GameOverPacket
-	(*RoundEndPacket)(nil),   // 5: api.RoundEndPacket
-	(*MatchStatePacket)(nil), // 6: api.MatchStatePacket
-	(*PlayCardRequest)(nil),  // 7: api.PlayCardRequest
-	(*TurnUpdatePacket)(nil), // 8: api.TurnUpdatePacket
+	(OpCode)(0),                // 0: api.OpCode
+	(*Card)(nil),               // 1: api.Card
+	(*HandUpdatePacket)(nil),   // 2: api.HandUpdatePacket
+	(*MatchStartPacket)(nil),   // 3: api.MatchStartPacket
+	(*GameOverPacket)(nil),     // 4: api.GameOverPacket
+	(*DealReveal)(nil),         // 5: api.DealReveal
+	(*PlayerResult)(nil),       // 6: api.PlayerResult
+	(*InstantWinPacket)(nil),   // 7: api.InstantWinPacket
+	(*ChopPacket)(nil),         // 8: api.ChopPacket
+	(*RoundEndPacket)(nil),     // 9: api.RoundEndPacket
+	(*MatchStatePacket)(nil),   // 10: api.MatchStatePacket
+	(*RoomConfig)(nil),         // 11: api.RoomConfig
+	(*ReadyRequest)(nil),       // 12: api.ReadyRequest
+	(*AddBotRequest)(nil),      // 13: api.AddBotRequest
+	(*RemoveBotRequest)(nil),   // 14: api.RemoveBotRequest
+	(*PlayCardRequest)(nil),    // 15: api.PlayCardRequest
+	(*HintMove)(nil),           // 16: api.HintMove
+	(*HintPacket)(nil),         // 17: api.HintPacket
+	(*TurnUpdatePacket)(nil),   // 18: api.TurnUpdatePacket
+	(*TurnTimedOutPacket)(nil), // 19: api.TurnTimedOutPacket
+	(*GameState)(nil),          // 20: api.GameState
+	(*DealConfig)(nil),         // 21: api.DealConfig
+	(*LogEntry)(nil),           // 22: api.LogEntry
+	(*CardList)(nil),           // 23: api.CardList
+	nil,                        // 24: api.MatchStatePacket.TimeBankMsEntry
+	nil,                        // 25: api.TurnUpdatePacket.TimeBankMsEntry
+	nil,                        // 26: api.GameState.HandsEntry
+	nil,                        // 27: api.GameState.HandVersionsEntry
+	nil,                        // 28: api.GameState.CardsPlayedEntry
 }
 var file_game_proto_depIdxs = []int32{
-	1, // 0: api.HandUpdatePacket.hand:type_name -> api.Card
-	1, // 1: api.MatchStartPacket.hand:type_name -> api.Card
-	1, // 2: api.MatchStatePacket.board:type_name -> api.Card
-	1, // 3: api.TurnUpdatePacket.last_played_cards:type_name -> api.Card
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: api.HandUpdatePacket.hand:type_name -> api.Card
+	1,  // 1: api.MatchStartPacket.hand:type_name -> api.Card
+	1,  // 2: api.MatchStartPacket.opening_card:type_name -> api.Card
+	6,  // 3: api.GameOverPacket.results:type_name -> api.PlayerResult
+	5,  // 4: api.GameOverPacket.deal:type_name -> api.DealReveal
+	1,  // 5: api.DealReveal.deck:type_name -> api.Card
+	1,  // 6: api.PlayerResult.remaining_hand:type_name -> api.Card
+	1,  // 7: api.InstantWinPacket.hand:type_name -> api.Card
+	1,  // 8: api.ChopPacket.chopped_cards:type_name -> api.Card
+	1,  // 9: api.ChopPacket.bomb_cards:type_name -> api.Card
+	1,  // 10: api.MatchStatePacket.board:type_name -> api.Card
+	1,  // 11: api.MatchStatePacket.opening_card:type_name -> api.Card
+	24, // 12: api.MatchStatePacket.time_bank_ms:type_name -> api.MatchStatePacket.TimeBankMsEntry
+	11, // 13: api.MatchStatePacket.config:type_name -> api.RoomConfig
+	1,  // 14: api.PlayCardRequest.cards:type_name -> api.Card
+	1,  // 15: api.HintMove.cards:type_name -> api.Card
+	16, // 16: api.HintPacket.moves:type_name -> api.HintMove
+	1,  // 17: api.TurnUpdatePacket.last_played_cards:type_name -> api.Card
+	25, // 18: api.TurnUpdatePacket.time_bank_ms:type_name -> api.TurnUpdatePacket.TimeBankMsEntry
+	1,  // 19: api.TurnTimedOutPacket.cards:type_name -> api.Card
+	26, // 20: api.GameState.hands:type_name -> api.GameState.HandsEntry
+	27, // 21: api.GameState.hand_versions:type_name -> api.GameState.HandVersionsEntry
+	1,  // 22: api.GameState.deck:type_name -> api.Card
+	5,  // 23: api.GameState.commitment:type_name -> api.DealReveal
+	1,  // 24: api.GameState.board:type_name -> api.Card
+	8,  // 25: api.GameState.chops:type_name -> api.ChopPacket
+	28, // 26: api.GameState.cards_played:type_name -> api.GameState.CardsPlayedEntry
+	22, // 27: api.GameState.log:type_name -> api.LogEntry
+	21, // 28: api.GameState.deal:type_name -> api.DealConfig
+	1,  // 29: api.GameState.opening_card:type_name -> api.Card
+	1,  // 30: api.LogEntry.cards:type_name -> api.Card
+	5,  // 31: api.LogEntry.commitment:type_name -> api.DealReveal
+	21, // 32: api.LogEntry.deal:type_name -> api.DealConfig
+	1,  // 33: api.CardList.cards:type_name -> api.Card
+	23, // 34: api.GameState.HandsEntry.value:type_name -> api.CardList
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_game_proto_init() }
@@ -621,7 +2412,7 @@ func file_game_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_proto_rawDesc), len(file_game_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   8,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},